@@ -0,0 +1,211 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+	"github.com/RafalKorepta/most-popular-committer/pkg/server"
+)
+
+const (
+	addressFlag    = "address"
+	languageFlag   = "language"
+	jsonFlag       = "json"
+	formatFlag     = "format"
+	serverNameFlag = "server_name"
+
+	clientDialTimeout = 10 * time.Second
+)
+
+// clientCmd represents the client command
+var clientCmd = &cobra.Command{
+	Use:   "client",
+	Short: "Calls a running server",
+	Long: `The command for smoke testing a deployment. It dials
+the gRPC endpoint, invokes MostActiveCommitter with the given
+language and prints the ranked committers`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		language, err := cmd.Flags().GetString(languageFlag)
+		if err != nil {
+			return err
+		}
+		if language == "" {
+			return fmt.Errorf("the --%s flag needs to be provided", languageFlag)
+		}
+
+		print, err := clientPrinter(cmd)
+		if err != nil {
+			return err
+		}
+
+		dialOpts, err := clientDialOpts(cmd)
+		if err != nil {
+			return err
+		}
+
+		address, err := cmd.Flags().GetString(addressFlag)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), clientDialTimeout)
+		defer cancel()
+
+		conn, err := grpc.DialContext(ctx, address, dialOpts...)
+		if err != nil {
+			return fmt.Errorf("unable to dial %s: %v", address, err)
+		}
+		defer conn.Close()
+
+		resp, err := pb.NewCommitterServiceClient(conn).MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language: language,
+		})
+		if err != nil {
+			return err
+		}
+
+		return print(cmd, resp)
+	},
+}
+
+// clientPrinter resolves the output format before the server is even
+// dialed, so a typo fails fast. The legacy --json flag keeps working as
+// a shorthand for --format=json
+func clientPrinter(cmd *cobra.Command) (func(*cobra.Command, *pb.CommitterResponse) error, error) {
+	format, err := cmd.Flags().GetString(formatFlag)
+	if err != nil {
+		return nil, err
+	}
+	if asJSON, err := cmd.Flags().GetBool(jsonFlag); err != nil {
+		return nil, err
+	} else if asJSON {
+		format = "json"
+	}
+
+	switch format {
+	case "table":
+		return printTable, nil
+	case "json":
+		return printJSON, nil
+	case "csv":
+		return printCSV, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q, accepts table, json or csv", format)
+	}
+}
+
+// clientDialOpts mirrors how the gateway dials the gRPC endpoint. TLS
+// mode reuses the same dial options, cleartext mode dials insecurely.
+func clientDialOpts(cmd *cobra.Command) ([]grpc.DialOption, error) {
+	secure, err := cmd.Flags().GetBool(secureFlag)
+	if err != nil {
+		return nil, err
+	}
+	if !secure {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	serverName, err := cmd.Flags().GetString(serverNameFlag)
+	if err != nil {
+		return nil, err
+	}
+	certPath, err := cmd.Flags().GetString(certPathFlag)
+	if err != nil {
+		return nil, err
+	}
+	certFile, err := cmd.Flags().GetString(certFileNameFlag)
+	if err != nil {
+		return nil, err
+	}
+	return server.CreateSecureDialOpts(serverName, filepath.Join(certPath, certFile))
+}
+
+// printTable renders the ranked committers as an aligned table
+func printTable(cmd *cobra.Command, resp *pb.CommitterResponse) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCOMMITS\tREPOSITORY")
+	for _, c := range resp.Contributors {
+		fmt.Fprintf(w, "%s\t%d\t%s\n", c.Name, c.Commits, c.GetRepository().GetName())
+	}
+	return w.Flush()
+}
+
+// printCSV renders the ranked committers as name,commits rows, ready to
+// be fed into a spreadsheet or a data pipeline
+func printCSV(cmd *cobra.Command, resp *pb.CommitterResponse) error {
+	w := csv.NewWriter(cmd.OutOrStdout())
+	if err := w.Write([]string{"name", "commits"}); err != nil {
+		return err
+	}
+	for _, c := range resp.Contributors {
+		if err := w.Write([]string{c.Name, strconv.FormatUint(c.Commits, 10)}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// printJSON renders the response the same way the HTTP gateway does
+func printJSON(cmd *cobra.Command, resp *pb.CommitterResponse) error {
+	m := &jsonpb.Marshaler{
+		OrigName:     true,
+		EmitDefaults: true,
+		Indent:       "  ",
+	}
+	if err := m.Marshal(cmd.OutOrStdout(), resp); err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout())
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(clientCmd)
+
+	// The flags are read from the command directly, instead of viper,
+	// so they do not clash with the serve command flags bound under
+	// the same keys
+	clientCmd.Flags().String(addressFlag, "127.0.0.1:9091",
+		"the gRPC address of the running server")
+	clientCmd.Flags().String(languageFlag, "",
+		"the programmatic language to rank the committers for")
+	clientCmd.Flags().Bool(jsonFlag, false,
+		"print the response as JSON instead of a table. "+
+			"Shorthand for --format=json")
+	clientCmd.Flags().String(formatFlag, "table",
+		"how the ranked committers are printed, either table, json or "+
+			"csv with name,commits columns")
+	clientCmd.Flags().BoolP(secureFlag, "s", false,
+		"dial the server over TLS using the same dial options as the gateway")
+	clientCmd.Flags().String(serverNameFlag, "most-popular-committer",
+		"the server name expected in the TLS certificate")
+	clientCmd.Flags().String(certPathFlag, "pkg/certs/local_certs",
+		"the path where the certificate is located")
+	clientCmd.Flags().String(certFileNameFlag, "server.pem",
+		"the certificate file used to verify the server")
+}
@@ -0,0 +1,81 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/RafalKorepta/most-popular-committer/pkg/certs"
+)
+
+const (
+	hostsFlag    = "hosts"
+	validityFlag = "validity"
+)
+
+// genCertsCmd represents the gen-certs command
+var genCertsCmd = &cobra.Command{
+	Use:   "gen-certs",
+	Short: "Generates self-signed development certificates",
+	Long: `The command generates a self-signed CA and a server keypair
+signed by it. The files are written as server.pem and server.key, the
+names the serve command expects. Every host given with --hosts becomes
+a Subject Alternative Name, so the certificate verifies for all the
+addresses the server is reachable at`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := cmd.Flags().GetString(certPathFlag)
+		if err != nil {
+			return err
+		}
+		name, err := cmd.Flags().GetString(serverNameFlag)
+		if err != nil {
+			return err
+		}
+		hosts, err := cmd.Flags().GetStringSlice(hostsFlag)
+		if err != nil {
+			return err
+		}
+		validity, err := cmd.Flags().GetDuration(validityFlag)
+		if err != nil {
+			return err
+		}
+
+		if err := certs.GenerateSelfSigned(dir, name, hosts, validity); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Certificates written to %s\n", dir)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(genCertsCmd)
+
+	// The flags are read from the command directly, instead of viper,
+	// so they do not clash with the serve command flags bound under
+	// the same keys
+	genCertsCmd.Flags().String(certPathFlag, "pkg/certs/local_certs",
+		"the directory where the certificates are written")
+	genCertsCmd.Flags().String(serverNameFlag, "most-popular-committer",
+		"the common name of the generated server certificate")
+	genCertsCmd.Flags().StringSlice(hostsFlag, []string{"localhost", "127.0.0.1", "most-popular-committer"},
+		"the host names and IPs the certificate is valid for")
+	genCertsCmd.Flags().Duration(validityFlag, 365*24*time.Hour,
+		"how long the generated certificates stay valid")
+}
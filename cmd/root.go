@@ -0,0 +1,165 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	LogLevel       = "log_level"
+	logFormatFlag  = "log_format"
+	logFileFlag    = "log_file"
+	configPathFlag = "cfg_path"
+	configFlag     = "config"
+	configTypeFlag = "config_type"
+)
+
+var (
+	// Version will be populated with binary semver by the linker
+	// during the build process.
+	// See https://blog.cloudflare.com/setting-go-variables-at-compile-time/
+	// and https://golang.org/cmd/link/ in section Flags `-X importpath.name=value`.
+	Version string
+
+	// Commit will be populated with correct git commit id by the linker
+	// during the build process.
+	// See https://blog.cloudflare.com/setting-go-variables-at-compile-time/
+	// and https://golang.org/cmd/link/ in section Flags `-X importpath.name=value`.
+	Commit string
+)
+
+// rootCmd represents the base command when called without any subcommands
+var rootCmd = &cobra.Command{
+	Use:   "most-popular-committer",
+	Short: "Most popular github project per language",
+	Long: `Server for finding most popular github
+projects per programmatic language`,
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func init() {
+	cobra.OnInitialize(initConfig)
+
+	rootCmd.PersistentFlags().StringP(LogLevel, "l", "DEBUG",
+		"Set logging level. Available DEBUG, INFO, WARN, ERROR, DPANIC, PANIC, FATAL")
+	rootCmd.PersistentFlags().String(logFormatFlag, "json",
+		"Set logging format. Available json, console")
+	rootCmd.PersistentFlags().String(logFileFlag, "",
+		"Additional file the logs are written to, next to stderr")
+	rootCmd.PersistentFlags().String(configPathFlag, ".", "Relative path where config resides")
+	rootCmd.PersistentFlags().String(configFlag, ".most-popular-committer",
+		"config file name, or a full file path like /etc/committer/config.toml "+
+			"(default is $HOME/.most-popular-committer.yml)")
+	rootCmd.PersistentFlags().String(configTypeFlag, "",
+		"config file format, one of yaml, json or toml. "+
+			"Detected from the file extension when empty")
+	if err := viper.BindPFlags(rootCmd.PersistentFlags()); err != nil {
+		zap.L().Error("Can not bind persistent flags")
+	}
+}
+
+// initConfig reads in config file and ENV variables if set.
+func initConfig() {
+	if cfg := viper.GetString(configFlag); strings.ContainsRune(cfg, os.PathSeparator) {
+		// A path like /etc/committer/config.toml points at one exact file
+		viper.SetConfigFile(cfg)
+	} else {
+		viper.SetConfigName(cfg) // name of config file (without extension)
+		viper.AddConfigPath(viper.GetString(configPathFlag))
+		viper.AddConfigPath("$HOME")
+	}
+	if cfgType := viper.GetString(configTypeFlag); cfgType != "" {
+		viper.SetConfigType(cfgType)
+	}
+
+	// Every flag can be set through an MPC_ prefixed environment
+	// variable, e.g. bind_address through MPC_BIND_ADDRESS. The prefix
+	// keeps generic names like PORT_NUMBER from colliding with platform
+	// provided variables. Flag defaults still apply when neither the
+	// flag nor the variable is set.
+	viper.SetEnvPrefix("MPC")
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_", ".", "_"))
+	viper.AutomaticEnv()
+
+	// If a config file is found, read it in. Running without any config
+	// file is supported, only a file that exists but does not parse is
+	// worth an error
+	if err := viper.ReadInConfig(); err != nil {
+		if _, notFound := err.(viper.ConfigFileNotFoundError); notFound {
+			zap.S().Debugw("No config file found, using flags and environment",
+				"error", err)
+		} else {
+			zap.S().Errorw("Failed to read from config file",
+				"configFile", viper.ConfigFileUsed(),
+				"error", err)
+		}
+	}
+
+	// Update global logger in debug configuration
+	cfg := zap.NewProductionConfig()
+	err := cfg.Level.UnmarshalText([]byte(viper.GetString(LogLevel)))
+	if err != nil {
+		log.Fatalf("Unable to convert log level. Error: %v", err)
+	}
+
+	switch format := viper.GetString(logFormatFlag); format {
+	case "json":
+	case "console":
+		cfg.Encoding = "console"
+		cfg.EncoderConfig = zap.NewDevelopmentEncoderConfig()
+	default:
+		log.Fatalf("Unknown log format %q. Available json, console", format)
+	}
+
+	if logFile := viper.GetString(logFileFlag); logFile != "" {
+		cfg.OutputPaths = append(cfg.OutputPaths, logFile)
+	}
+
+	newLogger, err := cfg.Build(zap.AddStacktrace(zap.ErrorLevel),
+		zap.Fields(
+			zap.Field{
+				Key:    "commit",
+				Type:   zapcore.StringType,
+				String: Commit,
+			},
+			zap.Field{
+				Key:    "version",
+				Type:   zapcore.StringType,
+				String: Version,
+			},
+		))
+	if err != nil {
+		log.Fatalf("Unable to create logger. Error: %v", err)
+	}
+	zap.ReplaceGlobals(newLogger)
+}
@@ -0,0 +1,287 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/RafalKorepta/most-popular-committer/pkg/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	portNumberFlag   = "port_number"
+	certPathFlag     = "certs_path"
+	certFileNameFlag = "cert_file_name"
+	keyFileNameFlag  = "key_file_name"
+	secureFlag       = "secure"
+	serverCapacity   = "capacity"
+	serverRate       = "rate"
+	githubTokenFlag  = "github_token"
+	githubBaseFlag   = "github_base_url"
+
+	insecureUpstreamFlag = "insecure_upstream"
+	bindAddressFlag      = "bind_address"
+	socketFlag           = "socket"
+	backendFlag          = "backend"
+
+	maxContributorsFlag = "max_contributors"
+	topRepositoriesFlag = "top_repositories"
+	maxStreamsFlag      = "max_streams"
+	maxConnectionsFlag  = "max_connections"
+
+	fakeFlag            = "fake"
+	reflectionFlag      = "reflection"
+	shutdownTimeoutFlag = "shutdown_timeout"
+	warmupLanguagesFlag = "warmup_languages"
+	webhookSecretFlag   = "webhook_secret"
+	checkFlag           = "check"
+)
+
+// serveCmd represents the serve command
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Starts the server",
+	Long: `The command for starting the service that
+search for most popular projects on github with
+the given programmatic language`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := validateServeConfig(); err != nil {
+			zap.L().Fatal("Invalid configuration", zap.Error(err))
+		}
+
+		if viper.GetBool(checkFlag) {
+			checkServeConfig()
+			return
+		}
+
+		listener, err := createListener()
+		if err != nil {
+			zap.L().Fatal("Can not listen for incoming requests", zap.Error(err))
+		}
+		srv, err := server.NewServer(listener, serverOptions()...)
+		if err != nil {
+			zap.L().Fatal("Unable to create server", zap.Error(err))
+		}
+
+		// Drain in-flight requests when the process is asked to stop
+		go func() {
+			sigCh := make(chan os.Signal, 1)
+			signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT)
+			sig := <-sigCh
+			zap.L().Info("Shutting down gracefully", zap.String("signal", sig.String()))
+			if err := srv.Shutdown(context.Background()); err != nil {
+				zap.L().Error("Graceful shutdown failed", zap.Error(err))
+			}
+		}()
+
+		err = srv.Serve()
+		if err != nil {
+			zap.L().Fatal("Server failed", zap.Error(err))
+		}
+	},
+}
+
+// serverOptions translates the serve command configuration into server
+// options. Shared between the normal start and the check mode
+func serverOptions() []server.Option {
+	opts := []server.Option{
+		server.WithLogger(zap.L()),
+		server.WithCapacity(viper.GetInt64(serverCapacity)),
+		server.WithRate(viper.GetInt64(serverRate)),
+		server.WithSecure(viper.GetBool(secureFlag)),
+		server.WithCertFile(filepath.Join(viper.GetString(certPathFlag), viper.GetString(certFileNameFlag))),
+		server.WithKeyFile(filepath.Join(viper.GetString(certPathFlag), viper.GetString(keyFileNameFlag))),
+		server.WithGitHubToken(viper.GetString(githubTokenFlag)),
+		server.WithGitHubBaseURL(viper.GetString(githubBaseFlag)),
+		server.WithDefaultMaxContributors(viper.GetInt(maxContributorsFlag)),
+		server.WithDefaultTopRepositories(viper.GetInt(topRepositoriesFlag)),
+		server.WithShutdownTimeout(viper.GetDuration(shutdownTimeoutFlag)),
+		server.WithWarmupLanguages(viper.GetStringSlice(warmupLanguagesFlag)...),
+		server.WithBackend(viper.GetString(backendFlag)),
+		server.WithWebhookSecret(viper.GetString(webhookSecretFlag)),
+		server.WithMaxConcurrentStreams(uint32(viper.GetInt(maxStreamsFlag))),
+		server.WithMaxConnections(viper.GetInt(maxConnectionsFlag)),
+	}
+	if viper.GetBool(insecureUpstreamFlag) {
+		opts = append(opts, server.WithInsecureUpstream())
+	}
+	if viper.GetBool(fakeFlag) {
+		opts = append(opts, server.WithFakeData())
+	}
+	if viper.GetBool(reflectionFlag) {
+		opts = append(opts, server.WithReflection())
+	}
+	return opts
+}
+
+// checkServeConfig runs the server setup, including the certificate
+// loading, without binding a port and exits with the outcome. Meant
+// for CI and deployment preflight checks
+func checkServeConfig() {
+	srv, err := server.NewServer(nopListener{}, serverOptions()...)
+	if err != nil {
+		zap.L().Fatal("Configuration check failed", zap.Error(err))
+	}
+	if err := srv.Check(); err != nil {
+		zap.L().Fatal("Configuration check failed", zap.Error(err))
+	}
+	zap.L().Info("Configuration check passed")
+}
+
+// nopListener stands in for the network listener in check mode, where
+// no port should be bound
+type nopListener struct{}
+
+func (nopListener) Accept() (net.Conn, error) {
+	return nil, fmt.Errorf("check mode does not accept connections")
+}
+func (nopListener) Close() error   { return nil }
+func (nopListener) Addr() net.Addr { return &net.TCPAddr{} }
+
+// validateServeConfig checks the configuration before any listener is
+// opened, so misconfiguration surfaces as one actionable error instead
+// of a failure deep inside the server
+func validateServeConfig() error {
+	if port := viper.GetInt(portNumberFlag); viper.GetString(socketFlag) == "" &&
+		(port < 1 || port > 65535) {
+		return fmt.Errorf("port number %d is outside the valid range 1-65535", port)
+	}
+	if rate := viper.GetInt64(serverRate); rate <= 0 {
+		return fmt.Errorf("rate %d must be a positive number of requests per second", rate)
+	}
+	if capacity := viper.GetInt64(serverCapacity); capacity <= 0 {
+		return fmt.Errorf("capacity %d must be a positive number of requests", capacity)
+	}
+
+	if base := viper.GetString(githubBaseFlag); base != "" {
+		if parsed, err := url.Parse(base); err != nil || !parsed.IsAbs() || parsed.Host == "" {
+			return fmt.Errorf("github base URL %q is not an absolute URL", base)
+		}
+	}
+
+	if viper.GetBool(secureFlag) {
+		certFile := filepath.Join(viper.GetString(certPathFlag), viper.GetString(certFileNameFlag))
+		keyFile := filepath.Join(viper.GetString(certPathFlag), viper.GetString(keyFileNameFlag))
+		if _, err := os.Stat(certFile); err != nil {
+			return fmt.Errorf("certificate file %q is not readable: %v", certFile, err)
+		}
+		if _, err := os.Stat(keyFile); err != nil {
+			return fmt.Errorf("key file %q is not readable: %v", keyFile, err)
+		}
+		if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+			return fmt.Errorf("certificate %q and key %q do not parse as a key pair: %v",
+				certFile, keyFile, err)
+		}
+	}
+	return nil
+}
+
+// createListener builds the network listener that the server accepts
+// connections on. A Unix domain socket takes precedence over TCP. The
+// socket file is unlinked when the listener is closed during shutdown.
+func createListener() (net.Listener, error) {
+	if socketPath := viper.GetString(socketFlag); socketPath != "" {
+		return net.Listen("unix", socketPath)
+	}
+
+	bindIP := net.ParseIP(viper.GetString(bindAddressFlag))
+	if bindIP == nil {
+		return nil, fmt.Errorf("malformed bind address %q", viper.GetString(bindAddressFlag))
+	}
+	return net.ListenTCP("tcp",
+		&net.TCPAddr{
+			IP:   bindIP,
+			Port: viper.GetInt(portNumberFlag),
+		})
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().String(backendFlag, "github",
+		"where the contributor data comes from, either github or mock "+
+			"which serves deterministic fixtures for integration tests")
+	serveCmd.Flags().String(socketFlag, "",
+		"the Unix domain socket path the server will be listen on instead of TCP, "+
+			"meant for sidecar deployments")
+	serveCmd.Flags().String(bindAddressFlag, "127.0.0.1",
+		"the address on which the server will be listen on incoming requests, "+
+			"e.g. 0.0.0.0 or :: to accept connections from any host")
+	serveCmd.Flags().IntP(portNumberFlag, "p", 9091,
+		"the port on which the server will be listen on incoming requests")
+	serveCmd.Flags().Int64P(serverCapacity, "c", 10,
+		"server request maximum capacity")
+	serveCmd.Flags().Int64P(serverRate, "r", 25,
+		"server per second request rate")
+	serveCmd.Flags().String(certPathFlag, "pkg/certs/local_certs",
+		"the path where key and certificate are located")
+	serveCmd.Flags().String(certFileNameFlag, "server.pem",
+		"the path where key and certificate are located")
+	serveCmd.Flags().String(keyFileNameFlag, "server.key",
+		"the path where key and certificate are located")
+	serveCmd.Flags().BoolP(secureFlag, "s", false,
+		"flag which change if email service will be serving tls connection or not")
+	serveCmd.Flags().Int(maxStreamsFlag, 250,
+		"how many streams a single HTTP/2 connection may multiplex, "+
+			"0 means unlimited")
+	serveCmd.Flags().Int(maxConnectionsFlag, 1024,
+		"how many connections are served at once in total, "+
+			"0 means unlimited")
+	serveCmd.Flags().Int(maxContributorsFlag, 0,
+		"instance wide default for the number of returned contributors")
+	serveCmd.Flags().Int(topRepositoriesFlag, 0,
+		"instance wide number of top rated repositories scanned for contributors")
+	serveCmd.Flags().StringSlice(warmupLanguagesFlag, nil,
+		"languages requested at start to prime the response cache before /readyz reports ready")
+	serveCmd.Flags().Duration(shutdownTimeoutFlag, 30*time.Second,
+		"how long the graceful shutdown waits for in-flight requests")
+	serveCmd.Flags().String(webhookSecretFlag, "",
+		"shared token that authenticates the cache invalidation webhook. "+
+			"The webhook stays disabled when empty")
+	serveCmd.Flags().Bool(fakeFlag, false,
+		"serve deterministic sample data without calling GitHub, "+
+			"for frontend development and demos. Never the default")
+	serveCmd.Flags().Bool(reflectionFlag, false,
+		"register the gRPC reflection service so tools like grpcurl can "+
+			"introspect the API. Off by default")
+	serveCmd.Flags().Bool(checkFlag, false,
+		"validate the configuration, including certificates, without binding a port "+
+			"and exit. Meant for deployment preflight checks")
+	serveCmd.Flags().Bool(insecureUpstreamFlag, false,
+		"skip TLS certificate verification on outbound GitHub calls. "+
+			"Meant for development setups with a broken trust store, never for production")
+	serveCmd.Flags().String(githubBaseFlag, "",
+		"GitHub API base URL for GitHub Enterprise Server deployments, "+
+			"e.g. https://github.example.com/api/v3/. Defaults to github.com")
+	serveCmd.Flags().String(githubTokenFlag, "",
+		"GitHub personal access token used for API calls. "+
+			"Can also be provided by the MPC_GITHUB_TOKEN environment variable")
+
+	if err := viper.BindPFlags(serveCmd.Flags()); err != nil {
+		zap.L().Error("Unable to bind flags")
+	}
+}
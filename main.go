@@ -0,0 +1,9 @@
+package main
+
+import (
+	"github.com/RafalKorepta/most-popular-committer/cmd"
+)
+
+func main() {
+	cmd.Execute()
+}
@@ -0,0 +1,564 @@
+package committer
+
+const (
+	swagger = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "committer.proto",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/v1alpha1/committer": {
+      "get": {
+        "summary": "SendMail",
+        "operationId": "MostActiveCommitter",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v1alpha1CommitterResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "language",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "max_contributors",
+            "description": "How many contributors should be returned. Server will use 10\nwhen not set and will clamp values bigger than 100.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "organizations",
+            "description": "Limit the search to the given GitHub organizations. The ranking\nspans all of them when more than one is provided.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "no_cache",
+            "description": "Bypass the server side response cache when it is enabled.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "include_anonymous",
+            "description": "Whether anonymous contributors are counted. Accepts \"true\" or\n\"false\", the latter being the default. The server wide default\napplies when empty. Anonymous committers are reported under a\nsynthetic name as they have no login.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "min_stars",
+            "description": "Skip repositories with fewer stars than this threshold. All\nrepositories are considered when not set.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "ascending",
+            "description": "Return the top rated committers in ascending commit order\ninstead of the default descending one.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "repo_sort",
+            "description": "How the top rated repositories are selected. Defaults to the\nstar count.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "REPO_SORT_STARS",
+              "REPO_SORT_FORKS",
+              "REPO_SORT_UPDATED",
+              "REPO_SORT_HELP_WANTED_ISSUES"
+            ],
+            "default": "REPO_SORT_STARS"
+          },
+          {
+            "name": "strict_language",
+            "description": "Drop repositories whose GitHub reported primary language differs\nfrom the requested one. Search matches repositories that merely\ncontain the language, this keeps only those dominated by it.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "languages",
+            "description": "Rank committers for several languages in one request. The\nresponse then groups the committers per language in the results\nfield. The single language field keeps working unchanged.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "page",
+            "description": "One based page of the ranked committers to return. Defaults to\nthe first page.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "page_size",
+            "description": "How many committers a single page holds. Defaults to\nmax_contributors and is clamped like it.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "anon_attribution",
+            "description": "How commits of anonymous contributors are attributed when\ninclude_anonymous asks for them. Defaults to the synthetic\nnumbered names.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "ANON_ATTRIBUTION_NUMBERED",
+              "ANON_ATTRIBUTION_SKIP",
+              "ANON_ATTRIBUTION_BY_EMAIL",
+              "ANON_ATTRIBUTION_BY_NAME"
+            ],
+            "default": "ANON_ATTRIBUTION_NUMBERED"
+          },
+          {
+            "name": "org",
+            "description": "Limit the search to a single GitHub organization. It can be\ncombined with language or stand on its own, in which case the\ntop rated repositories of the organization are ranked\nregardless of their language.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "pushed_after",
+            "description": "Only consider repositories pushed at or after this RFC3339\ntimestamp, so currently active projects can be ranked instead\nof historically popular ones. All repositories are considered\nwhen not set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "pushed_before",
+            "description": "Only consider repositories pushed at or before this RFC3339\ntimestamp. It must not precede pushed_after when both are set.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "score_mode",
+            "description": "How committers are scored before ranking. Defaults to the raw\ncommit count.",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "SCORE_MODE_RAW",
+              "SCORE_MODE_SHARE"
+            ],
+            "default": "SCORE_MODE_RAW"
+          },
+          {
+            "name": "short_circuit",
+            "description": "Stop scanning further repositories once max_contributors\ndistinct committers were collected, saving GitHub calls. The\nranking can differ from a full scan because the skipped\nrepositories are never queried.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          }
+        ],
+        "tags": [
+          "CommitterService"
+        ]
+      }
+    },
+    "/v1alpha1/committer/batch": {
+      "post": {
+        "summary": "Answer several committer queries in one round trip. The entries\nfail independently, a failed query is reported in its result\ninstead of failing the whole batch.",
+        "operationId": "BatchMostActiveCommitter",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v1alpha1BatchCommitterResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v1alpha1BatchCommitterRequest"
+            }
+          }
+        ],
+        "tags": [
+          "CommitterService"
+        ]
+      }
+    },
+    "/v1alpha1/languages": {
+      "get": {
+        "summary": "Aggregate the languages of the top starred repositories, ranked\nby their combined star count",
+        "operationId": "ListPopularLanguages",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v1alpha1LanguagesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "max_languages",
+            "description": "How many languages should be returned. Server will use 10 when\nnot set.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "top_repositories",
+            "description": "How many top starred repositories are aggregated. The server\nwide top_repositories default applies when not set.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          }
+        ],
+        "tags": [
+          "CommitterService"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "v1alpha1AnonAttribution": {
+      "type": "string",
+      "enum": [
+        "ANON_ATTRIBUTION_NUMBERED",
+        "ANON_ATTRIBUTION_SKIP",
+        "ANON_ATTRIBUTION_BY_EMAIL",
+        "ANON_ATTRIBUTION_BY_NAME"
+      ],
+      "default": "ANON_ATTRIBUTION_NUMBERED",
+      "description": "AnonAttribution selects how commits of anonymous contributors, the\nentries without a GitHub login, are attributed.\n\n - ANON_ATTRIBUTION_NUMBERED: Report anonymous contributors under a synthetic numbered name,\nthe default.\n - ANON_ATTRIBUTION_SKIP: Drop anonymous contributors even when they are fetched.\n - ANON_ATTRIBUTION_BY_EMAIL: Report anonymous contributors under their commit email. Not\nserved yet, the pinned GitHub client does not decode the email\nof anonymous entries.\n - ANON_ATTRIBUTION_BY_NAME: Report anonymous contributors under their commit name. Not\nserved yet for the same reason as ANON_ATTRIBUTION_BY_EMAIL."
+    },
+    "v1alpha1BatchCommitterRequest": {
+      "type": "object",
+      "properties": {
+        "requests": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1alpha1CommitterRequest"
+          },
+          "description": "The queries answered in one round trip."
+        }
+      }
+    },
+    "v1alpha1BatchCommitterResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1alpha1BatchCommitterResult"
+          },
+          "description": "One result per request, in request order."
+        }
+      }
+    },
+    "v1alpha1BatchCommitterResult": {
+      "type": "object",
+      "properties": {
+        "response": {
+          "$ref": "#/definitions/v1alpha1CommitterResponse"
+        },
+        "code": {
+          "type": "integer",
+          "format": "int64",
+          "description": "The gRPC status code of a failed entry, zero on success."
+        },
+        "error": {
+          "type": "string",
+          "description": "The status message of a failed entry."
+        }
+      },
+      "description": "BatchCommitterResult carries the outcome of a single batch entry.\nFailed entries leave the response empty and report the failure in\nthe code and error fields instead."
+    },
+    "v1alpha1CommitterRequest": {
+      "type": "object",
+      "properties": {
+        "language": {
+          "type": "string"
+        },
+        "max_contributors": {
+          "type": "integer",
+          "format": "int64",
+          "description": "How many contributors should be returned. Server will use 10\nwhen not set and will clamp values bigger than 100."
+        },
+        "organizations": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Limit the search to the given GitHub organizations. The ranking\nspans all of them when more than one is provided."
+        },
+        "no_cache": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Bypass the server side response cache when it is enabled."
+        },
+        "include_anonymous": {
+          "type": "string",
+          "description": "Whether anonymous contributors are counted. Accepts \"true\" or\n\"false\", the latter being the default. The server wide default\napplies when empty. Anonymous committers are reported under a\nsynthetic name as they have no login."
+        },
+        "min_stars": {
+          "type": "integer",
+          "format": "int64",
+          "description": "Skip repositories with fewer stars than this threshold. All\nrepositories are considered when not set."
+        },
+        "ascending": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Return the top rated committers in ascending commit order\ninstead of the default descending one."
+        },
+        "repo_sort": {
+          "$ref": "#/definitions/v1alpha1RepoSort",
+          "description": "How the top rated repositories are selected. Defaults to the\nstar count."
+        },
+        "strict_language": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Drop repositories whose GitHub reported primary language differs\nfrom the requested one. Search matches repositories that merely\ncontain the language, this keeps only those dominated by it."
+        },
+        "languages": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Rank committers for several languages in one request. The\nresponse then groups the committers per language in the results\nfield. The single language field keeps working unchanged."
+        },
+        "page": {
+          "type": "integer",
+          "format": "int64",
+          "description": "One based page of the ranked committers to return. Defaults to\nthe first page."
+        },
+        "page_size": {
+          "type": "integer",
+          "format": "int64",
+          "description": "How many committers a single page holds. Defaults to\nmax_contributors and is clamped like it."
+        },
+        "anon_attribution": {
+          "$ref": "#/definitions/v1alpha1AnonAttribution",
+          "description": "How commits of anonymous contributors are attributed when\ninclude_anonymous asks for them. Defaults to the synthetic\nnumbered names."
+        },
+        "org": {
+          "type": "string",
+          "description": "Limit the search to a single GitHub organization. It can be\ncombined with language or stand on its own, in which case the\ntop rated repositories of the organization are ranked\nregardless of their language."
+        },
+        "pushed_after": {
+          "type": "string",
+          "description": "Only consider repositories pushed at or after this RFC3339\ntimestamp, so currently active projects can be ranked instead\nof historically popular ones. All repositories are considered\nwhen not set."
+        },
+        "pushed_before": {
+          "type": "string",
+          "description": "Only consider repositories pushed at or before this RFC3339\ntimestamp. It must not precede pushed_after when both are set."
+        },
+        "score_mode": {
+          "$ref": "#/definitions/v1alpha1ScoreMode",
+          "description": "How committers are scored before ranking. Defaults to the raw\ncommit count."
+        },
+        "short_circuit": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Stop scanning further repositories once max_contributors\ndistinct committers were collected, saving GitHub calls. The\nranking can differ from a full scan because the skipped\nrepositories are never queried."
+        }
+      }
+    },
+    "v1alpha1RepoSort": {
+      "type": "string",
+      "enum": [
+        "REPO_SORT_STARS",
+        "REPO_SORT_FORKS",
+        "REPO_SORT_UPDATED",
+        "REPO_SORT_HELP_WANTED_ISSUES"
+      ],
+      "default": "REPO_SORT_STARS",
+      "description": "RepoSort selects the GitHub search sort key used to pick the top\nrated repositories."
+    },
+    "v1alpha1ScoreMode": {
+      "type": "string",
+      "enum": [
+        "SCORE_MODE_RAW",
+        "SCORE_MODE_SHARE"
+      ],
+      "default": "SCORE_MODE_RAW",
+      "description": "ScoreMode selects how committers are scored before they are ranked."
+    },
+    "v1alpha1Committer": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "commits": {
+          "type": "string",
+          "format": "uint64"
+        },
+        "repository": {
+          "$ref": "#/definitions/v1alpha1Repository",
+          "description": "The repository the contributions were counted in."
+        },
+        "score": {
+          "type": "number",
+          "format": "double",
+          "description": "The committer's share of the collected contributions of the\nrepository. Only populated in the share score mode, the raw mode\nranks by the plain commit count."
+        }
+      }
+    },
+    "v1alpha1Repository": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "primary_language": {
+          "type": "string",
+          "description": "The primary language GitHub reports for the repository."
+        },
+        "full_name": {
+          "type": "string",
+          "description": "The owner qualified name, for example \"golang/go\"."
+        },
+        "stars": {
+          "type": "string",
+          "format": "uint64",
+          "description": "The star count of the repository."
+        },
+        "html_url": {
+          "type": "string",
+          "description": "The GitHub page of the repository, so a UI can link back to it."
+        }
+      },
+      "description": "Repository carries metadata about the repository a committer was\nfound in."
+    },
+    "v1alpha1CommitterResponse": {
+      "type": "object",
+      "properties": {
+        "language": {
+          "type": "string"
+        },
+        "contributors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1alpha1Committer"
+          }
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1alpha1LanguageResult"
+          },
+          "description": "One committer ranking per requested language. Only populated\nwhen the request used the languages field."
+        },
+        "total_count": {
+          "type": "integer",
+          "format": "int64",
+          "description": "How many committers were collected in total before pagination."
+        },
+        "next_page": {
+          "type": "integer",
+          "format": "int64",
+          "description": "The number of the next page, zero when this is the last one."
+        },
+        "total_repositories": {
+          "type": "string",
+          "format": "uint64",
+          "description": "How many repositories GitHub reports for the search in total.\nOnly the top rated ones are scanned, so the response is a sample\nof the most popular projects, not an exhaustive ranking."
+        },
+        "incomplete_results": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Whether GitHub reported the search results as incomplete."
+        }
+      }
+    },
+    "v1alpha1LanguageResult": {
+      "type": "object",
+      "properties": {
+        "language": {
+          "type": "string"
+        },
+        "contributors": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1alpha1Committer"
+          }
+        }
+      },
+      "description": "LanguageResult groups the ranked committers of a single language\nwithin a multi language response."
+    },
+    "v1alpha1LanguagesResponse": {
+      "type": "object",
+      "properties": {
+        "languages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1alpha1LanguageStat"
+          },
+          "description": "The languages ranked by their aggregate star count."
+        }
+      }
+    },
+    "v1alpha1LanguageStat": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "stars": {
+          "type": "string",
+          "format": "uint64",
+          "description": "The combined star count of the aggregated repositories."
+        },
+        "repositories": {
+          "type": "integer",
+          "format": "int64",
+          "description": "How many of the top starred repositories use the language."
+        }
+      },
+      "description": "LanguageStat aggregates the top starred repositories that share a\nprimary language."
+    }
+  }
+}
+`
+)
@@ -0,0 +1,115 @@
+// Copyright [2018] [Rafał Korepta]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSelfSigned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gencerts")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	hosts := []string{"localhost", "127.0.0.1", "most-popular-committer"}
+	require.NoError(t, GenerateSelfSigned(dir, "most-popular-committer", hosts, 24*time.Hour))
+
+	certFile := filepath.Join(dir, "server.pem")
+	keyFile := filepath.Join(dir, "server.key")
+
+	t.Run("The generated keypair loads", func(t *testing.T) {
+		_, err := tls.LoadX509KeyPair(certFile, keyFile)
+		require.NoError(t, err)
+	})
+
+	t.Run("The certificate verifies for every host", func(t *testing.T) {
+		// Given the generated chain as the root of trust, the same
+		// way CreateSecureDialOpts consumes it
+		roots, err := CreatePool(certFile)
+		require.NoError(t, err)
+
+		keyPair, err := tls.LoadX509KeyPair(certFile, keyFile)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+		require.NoError(t, err)
+
+		// Then the leaf verifies for all the requested hosts
+		for _, host := range hosts {
+			_, err := leaf.Verify(x509.VerifyOptions{
+				Roots:   roots,
+				DNSName: host,
+			})
+			assert.NoError(t, err, "host %s", host)
+		}
+	})
+
+	t.Run("The CA is written next to the server keypair", func(t *testing.T) {
+		_, err := CreatePool(filepath.Join(dir, "ca.pem"))
+		require.NoError(t, err)
+	})
+}
+
+func TestHandshakeWithMultipleDNSSANs(t *testing.T) {
+	// Given a server that presents a certificate with two DNS names
+	dir, err := ioutil.TempDir("", "gencerts")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	names := []string{"api.example.com", "internal.example.net"}
+	require.NoError(t, GenerateSelfSigned(dir, "most-popular-committer", names, 24*time.Hour))
+
+	tlsCfg, err := CreateTLSConfig(filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key"))
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", tlsCfg)
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_ = conn.(*tls.Conn).Handshake()
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	roots, err := CreatePool(filepath.Join(dir, "server.pem"))
+	require.NoError(t, err)
+
+	// When clients expecting either name connect
+	for _, name := range names {
+		conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{
+			RootCAs:    roots,
+			ServerName: name,
+		})
+
+		// Then the handshake verifies against the matching SAN
+		require.NoError(t, err, "server name %s", name)
+		require.NoError(t, conn.Close())
+	}
+}
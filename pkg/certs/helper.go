@@ -0,0 +1,121 @@
+// Copyright [2018] [Rafał Korepta]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+func CreateX509Pool(cert io.Reader) (_ *x509.CertPool, err error) {
+	if cert == nil {
+		return nil, fmt.Errorf("cert can not be nil")
+	}
+
+	// An unusable reader, such as a zero value os.File, panics inside
+	// Read. The panic is turned into an error so bad input cannot
+	// crash the process.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("can not read the certificate: %v", r)
+		}
+	}()
+
+	b, err := ioutil.ReadAll(cert)
+	if err != nil {
+		return nil, fmt.Errorf("can not read the certificate")
+	}
+
+	demoCertPool := x509.NewCertPool()
+	ok := demoCertPool.AppendCertsFromPEM(b)
+	if !ok {
+		return nil, fmt.Errorf("could not append certificate")
+	}
+	return demoCertPool, nil
+}
+
+// CreatePoolFromPEM builds a certificate pool from in-memory PEM
+// bytes, for example injected through an environment variable or a
+// mounted secret
+func CreatePoolFromPEM(caPEM []byte) (*x509.CertPool, error) {
+	return CreateX509Pool(bytes.NewReader(caPEM))
+}
+
+func CreatePool(certFile string) (*x509.CertPool, error) {
+	b, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "opaning file")
+	}
+	certPool, err := CreatePoolFromPEM(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating x509 pool")
+	}
+	return certPool, nil
+}
+
+func CreateTLSConfig(certFile, keyFile string) (*tls.Config, error) {
+	// The keypair is served through a reloader so certificate
+	// rotations are picked up without restarting the server
+	reloader, err := NewKeypairReloader(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create x509 key pair certificate: %v", err)
+	}
+
+	return &tls.Config{
+		GetCertificate: reloader.GetCertificate,
+		NextProtos:     []string{"h2"},
+	}, nil
+}
+
+// CreateTLSConfigFromPEM builds the same TLS configuration as
+// CreateTLSConfig from in-memory PEM bytes. Unlike the file-based
+// variant the keypair cannot be hot-reloaded, rotations are handled by
+// whoever injects the bytes
+func CreateTLSConfigFromPEM(certPEM, keyPEM []byte) (*tls.Config, error) {
+	keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create x509 key pair certificate: %v", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		NextProtos:   []string{"h2"},
+	}, nil
+}
+
+// CreateMutualTLSConfig builds the same TLS configuration as
+// CreateTLSConfig but additionally requires clients to present a
+// certificate signed by the CA found in clientCAFile
+func CreateMutualTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	tlsCfg, err := CreateTLSConfig(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCAs, err := CreatePool(clientCAFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading client CA")
+	}
+
+	tlsCfg.ClientCAs = clientCAs
+	tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return tlsCfg, nil
+}
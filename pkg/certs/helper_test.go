@@ -0,0 +1,222 @@
+// Copyright [2018] [Rafał Korepta]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package certs
+
+import (
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"os"
+
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testData = "local_certs"
+
+type MockReader struct {
+	io.Reader
+}
+
+func (MockReader) Read(p []byte) (n int, err error) { return 0, fmt.Errorf("test error") }
+
+func Test_CreateX509Pool(t *testing.T) {
+	// Arrange
+	cert, emptyFile := helperLoadFiles(t)
+	defer cleanup()
+
+	t.Run("Correct creation of x509 cert pool", func(t *testing.T) {
+		// Act
+		certPool, err := CreateX509Pool(cert)
+
+		// Assert
+		assert.NoError(t, err, "Error should not occur")
+		assert.NotNil(t, certPool, "certPool must exist")
+	})
+
+	t.Run("The cert argument is nil", func(t *testing.T) {
+		// Act
+		certPool, err := CreateX509Pool(nil)
+
+		// Assert
+		assert.Error(t, err, "Error must occur")
+		assert.Nil(t, certPool, "certPool must not exist")
+	})
+
+	t.Run("The cert argument is empty file descriptor", func(t *testing.T) {
+		// Act
+		certPool, err := CreateX509Pool(&os.File{})
+
+		// Assert
+		assert.Error(t, err, "Error must occur")
+		assert.Nil(t, certPool, "certPool must not exist")
+	})
+
+	t.Run("The cert argument is io.Read which has Read implement to return an error", func(t *testing.T) {
+		// Arrange
+		mock := MockReader{}
+
+		// Act
+		certPool, err := CreateX509Pool(mock)
+
+		// Assert
+		assert.Error(t, err, "Error must occur")
+		assert.Nil(t, certPool, "certPool must not exist")
+	})
+
+	t.Run("The cert argument is file descriptor which points to empty file", func(t *testing.T) {
+		// Act
+		certPool, err := CreateX509Pool(emptyFile)
+
+		// Assert
+		assert.Error(t, err, "Error must occur")
+		assert.Nil(t, certPool, "certPool must not exist")
+	})
+}
+
+func TestCreatePool(t *testing.T) {
+	helperLoadFiles(t)
+	defer cleanup()
+
+	t.Run("Failed when cert file is an empty file", func(t *testing.T) {
+		_, err := CreatePool(filepath.Join(testData, "temp.pem"))
+
+		require.Error(t, err)
+	})
+
+	t.Run("Failed when file does not exist", func(t *testing.T) {
+		_, err := CreatePool("non existing file")
+
+		require.Error(t, err)
+	})
+
+	t.Run("Successful creates certificates pool", func(t *testing.T) {
+		pool, err := CreatePool(filepath.Join(testData, "server.pem"))
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, pool.Subjects())
+	})
+}
+
+func TestCreateTLSConfig(t *testing.T) {
+	t.Run("Failed when cert file does not exist", func(t *testing.T) {
+		_, err := CreateTLSConfig("non existing file", filepath.Join(testData, "server.key"))
+
+		require.Error(t, err)
+	})
+
+	t.Run("Failed when key file does not exist", func(t *testing.T) {
+		_, err := CreateTLSConfig(filepath.Join(testData, "server.pem"), "non existing file")
+
+		require.Error(t, err)
+	})
+
+	t.Run("Successful creates http tls config", func(t *testing.T) {
+		tlsCfg, err := CreateTLSConfig(filepath.Join(testData, "server.pem"), filepath.Join(testData, "server.key"))
+
+		require.NoError(t, err)
+		require.NotNil(t, tlsCfg.GetCertificate)
+		keyPair, err := tlsCfg.GetCertificate(nil)
+		require.NoError(t, err)
+		assert.NotEmpty(t, keyPair.Certificate)
+	})
+}
+
+func TestCreatePoolFromPEM(t *testing.T) {
+	t.Run("Failed when the bytes are not a certificate", func(t *testing.T) {
+		_, err := CreatePoolFromPEM([]byte("not a certificate"))
+
+		require.Error(t, err)
+	})
+
+	t.Run("Successful creates pool from in-memory bytes", func(t *testing.T) {
+		certPEM, err := ioutil.ReadFile(filepath.Join(testData, "server.pem"))
+		require.NoError(t, err)
+
+		pool, err := CreatePoolFromPEM(certPEM)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, pool.Subjects())
+	})
+}
+
+func TestCreateTLSConfigFromPEM(t *testing.T) {
+	t.Run("Failed when the bytes are not a keypair", func(t *testing.T) {
+		_, err := CreateTLSConfigFromPEM([]byte("not a certificate"), []byte("not a key"))
+
+		require.Error(t, err)
+	})
+
+	t.Run("Successful creates tls config from in-memory bytes", func(t *testing.T) {
+		certPEM, err := ioutil.ReadFile(filepath.Join(testData, "server.pem"))
+		require.NoError(t, err)
+		keyPEM, err := ioutil.ReadFile(filepath.Join(testData, "server.key"))
+		require.NoError(t, err)
+
+		tlsCfg, err := CreateTLSConfigFromPEM(certPEM, keyPEM)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, tlsCfg.Certificates)
+	})
+}
+
+func TestCreateMutualTLSConfig(t *testing.T) {
+	t.Run("Failed when client CA file does not exist", func(t *testing.T) {
+		_, err := CreateMutualTLSConfig(filepath.Join(testData, "server.pem"),
+			filepath.Join(testData, "server.key"), "non existing file")
+
+		require.Error(t, err)
+	})
+
+	t.Run("Failed when key pair does not exist", func(t *testing.T) {
+		_, err := CreateMutualTLSConfig("non existing file", "non existing file",
+			filepath.Join(testData, "server.pem"))
+
+		require.Error(t, err)
+	})
+
+	t.Run("Successful creates tls config that verifies clients", func(t *testing.T) {
+		tlsCfg, err := CreateMutualTLSConfig(filepath.Join(testData, "server.pem"),
+			filepath.Join(testData, "server.key"), filepath.Join(testData, "server.pem"))
+
+		require.NoError(t, err)
+		assert.NotNil(t, tlsCfg.GetCertificate)
+		assert.NotEmpty(t, tlsCfg.ClientCAs.Subjects())
+		assert.Equal(t, tls.RequireAndVerifyClientCert, tlsCfg.ClientAuth)
+	})
+}
+
+func cleanup() {
+	os.Remove(filepath.Join(testData, "temp.pem"))
+}
+
+func helperLoadFiles(t *testing.T) (*os.File, *os.File) {
+	certPath := filepath.Join(testData, "server.pem")
+	cert, err := os.Open(certPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emptyFile, err := os.Create(filepath.Join(testData, "temp.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, emptyFile
+}
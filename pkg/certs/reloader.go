@@ -0,0 +1,110 @@
+// Copyright [2018] [Rafał Korepta]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// certReloadInterval bounds how often the certificate files are checked
+// for changes so busy servers do not stat them on every handshake
+const certReloadInterval = 10 * time.Second
+
+// KeypairReloader hands out the server certificate and transparently
+// re-reads the certificate files when they change on disk, so rotated
+// certificates are served without restarting the server.
+type KeypairReloader struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	keyPair   *tls.Certificate
+	modTime   time.Time
+	nextCheck time.Time
+}
+
+// NewKeypairReloader loads the given keypair and watches both files for
+// changes by periodically checking their modification time
+func NewKeypairReloader(certFile, keyFile string) (*KeypairReloader, error) {
+	keyPair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading x509 key pair")
+	}
+
+	r := &KeypairReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: certReloadInterval,
+		now:      time.Now,
+		keyPair:  &keyPair,
+	}
+	r.modTime = r.statModTime()
+	r.nextCheck = r.now().Add(r.interval)
+	return r, nil
+}
+
+// GetCertificate serves the current keypair. It is meant to be plugged
+// into tls.Config.GetCertificate.
+func (r *KeypairReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now := r.now(); now.After(r.nextCheck) {
+		r.nextCheck = now.Add(r.interval)
+		r.maybeReload()
+	}
+	return r.keyPair, nil
+}
+
+// maybeReload swaps the keypair when the files changed since the last
+// load. The previous keypair stays in place when the new one cannot be
+// parsed, for example when the rotation has written only one file so
+// far.
+func (r *KeypairReloader) maybeReload() {
+	modTime := r.statModTime()
+	if modTime.Equal(r.modTime) {
+		return
+	}
+
+	keyPair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return
+	}
+	r.keyPair = &keyPair
+	r.modTime = modTime
+}
+
+// statModTime reports the newest modification time of the two files. A
+// zero time is reported when one of them cannot be checked.
+func (r *KeypairReloader) statModTime() time.Time {
+	var newest time.Time
+	for _, file := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}
+		}
+		if info.ModTime().After(newest) {
+			newest = info.ModTime()
+		}
+	}
+	return newest
+}
@@ -0,0 +1,128 @@
+// Copyright [2018] [Rafał Korepta]
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSelfSigned writes a self signed keypair with the given common
+// name to certFile and keyFile
+func writeSelfSigned(t *testing.T, certFile, keyFile, commonName string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	require.NoError(t, ioutil.WriteFile(certFile, certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+}
+
+// servedCommonName reports the common name of the certificate the
+// reloader currently serves
+func servedCommonName(t *testing.T, reloader *KeypairReloader) string {
+	keyPair, err := reloader.GetCertificate(nil)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	require.NoError(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestKeypairReloader(t *testing.T) {
+	newReloader := func(t *testing.T) (*KeypairReloader, string, string) {
+		dir, err := ioutil.TempDir("", "certs")
+		require.NoError(t, err)
+		t.Cleanup(func() { os.RemoveAll(dir) })
+
+		certFile := filepath.Join(dir, "server.pem")
+		keyFile := filepath.Join(dir, "server.key")
+		writeSelfSigned(t, certFile, keyFile, "first")
+
+		reloader, err := NewKeypairReloader(certFile, keyFile)
+		require.NoError(t, err)
+		return reloader, certFile, keyFile
+	}
+
+	t.Run("Replaced files are picked up after the check interval", func(t *testing.T) {
+		// Given a reloader serving the initial certificate
+		reloader, certFile, keyFile := newReloader(t)
+		now := time.Now()
+		reloader.now = func() time.Time { return now }
+		require.Equal(t, "first", servedCommonName(t, reloader))
+
+		// When the certificate files are replaced and the check
+		// interval passes
+		writeSelfSigned(t, certFile, keyFile, "second")
+		require.NoError(t, os.Chtimes(certFile, now.Add(time.Minute), now.Add(time.Minute)))
+		reloader.now = func() time.Time { return now.Add(certReloadInterval + time.Second) }
+
+		// Then the new certificate is served
+		assert.Equal(t, "second", servedCommonName(t, reloader))
+	})
+
+	t.Run("Unchanged files keep the parsed keypair", func(t *testing.T) {
+		// Given a reloader serving the initial certificate
+		reloader, _, _ := newReloader(t)
+		now := time.Now()
+
+		// When the check interval passes without a rotation
+		reloader.now = func() time.Time { return now.Add(certReloadInterval + time.Second) }
+
+		// Then the same certificate keeps being served
+		assert.Equal(t, "first", servedCommonName(t, reloader))
+	})
+
+	t.Run("Broken replacement keeps the previous certificate", func(t *testing.T) {
+		// Given a reloader whose certificate file was replaced with
+		// garbage, for example half way through a rotation
+		reloader, certFile, _ := newReloader(t)
+		now := time.Now()
+		require.NoError(t, ioutil.WriteFile(certFile, []byte("not a certificate"), 0600))
+		require.NoError(t, os.Chtimes(certFile, now.Add(time.Minute), now.Add(time.Minute)))
+
+		// When the check interval passes
+		reloader.now = func() time.Time { return now.Add(certReloadInterval + time.Second) }
+
+		// Then the previous certificate keeps being served
+		assert.Equal(t, "first", servedCommonName(t, reloader))
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package log
+
+import (
+	"go.uber.org/zap"
+)
+
+type ZapWrapper struct {
+	Logger *zap.Logger
+	Sugar  *zap.SugaredLogger
+}
+
+func (z ZapWrapper) Error(msg string) {
+	z.Logger.Error(msg)
+}
+
+func (z ZapWrapper) Infof(msg string, args ...interface{}) {
+	z.Sugar.Info(msg, args)
+}
@@ -0,0 +1,55 @@
+// Copyright 2018 Zheng Dayu. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package grpc_ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+const infinityDuration time.Duration = 0x7fffffffffffffff
+
+type Option func(*rateLimiter)
+
+// WithLimiter customizes your limiter in the middleware
+func WithLimiter(l Limiter) Option {
+	return func(r *rateLimiter) {
+		r.limiter = l
+		if r.maxWaitDuration == 0 {
+			r.maxWaitDuration = infinityDuration
+		}
+	}
+}
+
+// WithMaxWaitDuration customizes maxWaitDuration in limiter's WaitMaxDuration action.
+func WithMaxWaitDuration(maxWaitDuration time.Duration) Option {
+	return func(r *rateLimiter) {
+		r.maxWaitDuration = maxWaitDuration
+	}
+}
+
+// CostFunc computes how many tokens the given request consumes.
+// Results smaller than 1 are treated as 1.
+type CostFunc func(req interface{}) int64
+
+// WithCostFunc ties the consumed budget to the request content.
+// It is only effective when the configured limiter implements NLimiter.
+func WithCostFunc(f CostFunc) Option {
+	return func(r *rateLimiter) {
+		r.cost = f
+	}
+}
+
+// KeyFunc derives the limiter key of a request from its context, for
+// example the peer address.
+type KeyFunc func(ctx context.Context) string
+
+// WithKeyFunc partitions the budget by the given key so clients are
+// limited independently of each other. It is only effective when the
+// configured limiter implements KeyedLimiter.
+func WithKeyFunc(f KeyFunc) Option {
+	return func(r *rateLimiter) {
+		r.key = f
+	}
+}
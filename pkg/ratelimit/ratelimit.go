@@ -0,0 +1,157 @@
+// Copyright 2018 Zheng Dayu. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package grpc_ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type Limiter interface {
+	WaitMaxDuration(time.Duration) bool
+}
+
+// NLimiter is implemented by limiters that can take more than one
+// token at once. It enables cost based admission control where
+// expensive requests consume a bigger part of the budget.
+type NLimiter interface {
+	WaitNMaxDuration(n int64, maxWaitDuration time.Duration) bool
+}
+
+// KeyedLimiter is implemented by limiters that keep an independent
+// budget per key, such as one bucket per client. It enables per-client
+// rate limiting when combined with WithKeyFunc.
+type KeyedLimiter interface {
+	WaitKeyMaxDuration(key string, n int64, maxWaitDuration time.Duration) bool
+}
+
+// State is a point in time snapshot of a limiter for debugging, for
+// example how full the bucket is when clients see ResourceExhausted.
+type State struct {
+	// Available is the number of tokens that can be taken right now,
+	// summed over all buckets for keyed limiters
+	Available int64 `json:"available"`
+	// Capacity is the configured maximum number of tokens per bucket
+	Capacity int64 `json:"capacity"`
+	// Rate is the configured refill rate in tokens per second
+	Rate float64 `json:"rate"`
+	// Buckets is the number of tracked buckets, one per key for keyed
+	// limiters
+	Buckets int `json:"buckets"`
+	// Rejects counts the requests turned away since the limiter was
+	// created
+	Rejects int64 `json:"rejects"`
+}
+
+// StateReporter is implemented by limiters that can report their
+// current state for debugging.
+type StateReporter interface {
+	LimiterState() State
+}
+
+// RetryAfterKey is the metadata key carrying the number of seconds a
+// rejected client should wait before retrying
+const RetryAfterKey = "retry-after"
+
+// RetryAfterReporter is implemented by limiters that can estimate how
+// long it takes until the next token becomes available.
+type RetryAfterReporter interface {
+	RetryAfter() time.Duration
+}
+
+// retryAfterMetadata builds the retry-after metadata of a rejected
+// request, rounding the estimate up to whole seconds as the HTTP
+// Retry-After header expects
+func retryAfterMetadata(l Limiter) (metadata.MD, bool) {
+	reporter, ok := l.(RetryAfterReporter)
+	if !ok {
+		return nil, false
+	}
+	seconds := int64(math.Ceil(reporter.RetryAfter().Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return metadata.Pairs(RetryAfterKey, strconv.FormatInt(seconds, 10)), true
+}
+
+type rateLimiter struct {
+	limiter         Limiter
+	maxWaitDuration time.Duration
+	cost            CostFunc
+	key             KeyFunc
+}
+
+func (r *rateLimiter) Wait(ctx context.Context, req interface{}) bool {
+	n := int64(1)
+	if r.cost != nil {
+		if c := r.cost(req); c > 1 {
+			n = c
+		}
+	}
+
+	if r.key != nil {
+		if kl, ok := r.limiter.(KeyedLimiter); ok {
+			return kl.WaitKeyMaxDuration(r.key(ctx), n, r.maxWaitDuration)
+		}
+	}
+
+	if nl, ok := r.limiter.(NLimiter); ok {
+		return nl.WaitNMaxDuration(n, r.maxWaitDuration)
+	}
+
+	return r.limiter.WaitMaxDuration(r.maxWaitDuration)
+}
+
+type emptyLimiter struct{}
+
+func (e *emptyLimiter) WaitMaxDuration(time.Duration) bool {
+	return true
+}
+
+func emptyRatelimiter() *rateLimiter {
+	return &rateLimiter{
+		limiter: &emptyLimiter{},
+	}
+}
+
+// UnaryServerInterceptor returns a new unary server interceptors that performs request rate limit.
+func UnaryServerInterceptor(opts ...Option) grpc.UnaryServerInterceptor {
+	ratelimiter := emptyRatelimiter()
+	for _, opt := range opts {
+		opt(ratelimiter)
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if ratelimiter.Wait(ctx, req) {
+			return handler(ctx, req)
+		}
+		if md, ok := retryAfterMetadata(ratelimiter.limiter); ok {
+			_ = grpc.SetHeader(ctx, md)
+		}
+		return nil, status.Errorf(codes.ResourceExhausted, "%s is rejected by grpc_ratelimit middleare, please retry later.", info.FullMethod)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptors that performs request rate limit.
+func StreamServerInterceptor(opts ...Option) grpc.StreamServerInterceptor {
+	ratelimiter := emptyRatelimiter()
+	for _, opt := range opts {
+		opt(ratelimiter)
+	}
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if ratelimiter.Wait(stream.Context(), nil) {
+			return handler(srv, stream)
+		}
+		if md, ok := retryAfterMetadata(ratelimiter.limiter); ok {
+			_ = stream.SetHeader(md)
+		}
+		return status.Errorf(codes.ResourceExhausted, "%s is rejected by grpc_ratelimit middleare, please retry later.", info.FullMethod)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2018 Zheng Dayu. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package grpc_ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	grpc_ratelimit "github.com/RafalKorepta/most-popular-committer/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// recordingLimiter records how many tokens each request consumed.
+type recordingLimiter struct {
+	taken []int64
+}
+
+func (l *recordingLimiter) WaitMaxDuration(time.Duration) bool {
+	l.taken = append(l.taken, 1)
+	return true
+}
+
+func (l *recordingLimiter) WaitNMaxDuration(n int64, _ time.Duration) bool {
+	l.taken = append(l.taken, n)
+	return true
+}
+
+func TestUnaryServerInterceptor_CostFunc(t *testing.T) {
+	// Given limiter that records consumed tokens
+	limiter := &recordingLimiter{}
+
+	// And interceptor which charges one token per request byte
+	interceptor := grpc_ratelimit.UnaryServerInterceptor(
+		grpc_ratelimit.WithLimiter(limiter),
+		grpc_ratelimit.WithCostFunc(func(req interface{}) int64 {
+			return int64(len(req.(string)))
+		}),
+	)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	// When a small and a large request are intercepted
+	_, err := interceptor(context.TODO(), "ab", info, handler)
+	require.NoError(t, err)
+	_, err = interceptor(context.TODO(), "abcdef", info, handler)
+	require.NoError(t, err)
+
+	// Then the larger request consumed more budget
+	assert.Equal(t, []int64{2, 6}, limiter.taken)
+}
+
+// rejectingLimiter turns every request away with a fixed retry
+// estimate.
+type rejectingLimiter struct {
+	retryAfter time.Duration
+}
+
+func (l *rejectingLimiter) WaitMaxDuration(time.Duration) bool { return false }
+
+func (l *rejectingLimiter) RetryAfter() time.Duration { return l.retryAfter }
+
+// headerRecordingStream captures the header metadata set on the
+// server transport stream.
+type headerRecordingStream struct {
+	method string
+	header metadata.MD
+}
+
+func (s *headerRecordingStream) Method() string { return s.method }
+
+func (s *headerRecordingStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerRecordingStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerRecordingStream) SetTrailer(metadata.MD) error { return nil }
+
+func TestUnaryServerInterceptor_RetryAfter(t *testing.T) {
+	// Given interceptor whose limiter rejects with a retry estimate
+	interceptor := grpc_ratelimit.UnaryServerInterceptor(
+		grpc_ratelimit.WithLimiter(&rejectingLimiter{retryAfter: 2500 * time.Millisecond}),
+	)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	// When a request is rejected
+	stream := &headerRecordingStream{method: info.FullMethod}
+	ctx := grpc.NewContextWithServerTransportStream(context.TODO(), stream)
+	_, err := interceptor(ctx, nil, info, handler)
+
+	// Then the error carries a whole second retry-after header,
+	// rounded up from the limiter estimate
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"3"}, stream.header.Get(grpc_ratelimit.RetryAfterKey))
+}
+
+// recordingKeyedLimiter records the key each request was charged to.
+type recordingKeyedLimiter struct {
+	recordingLimiter
+
+	keys []string
+}
+
+func (l *recordingKeyedLimiter) WaitKeyMaxDuration(key string, n int64, _ time.Duration) bool {
+	l.keys = append(l.keys, key)
+	l.taken = append(l.taken, n)
+	return true
+}
+
+type ctxKey struct{}
+
+func TestUnaryServerInterceptor_KeyFunc(t *testing.T) {
+	// Given a keyed limiter that records the charged keys
+	limiter := &recordingKeyedLimiter{}
+
+	// And interceptor which derives the key from the context
+	interceptor := grpc_ratelimit.UnaryServerInterceptor(
+		grpc_ratelimit.WithLimiter(limiter),
+		grpc_ratelimit.WithKeyFunc(func(ctx context.Context) string {
+			key, _ := ctx.Value(ctxKey{}).(string)
+			return key
+		}),
+	)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	// When requests of two clients are intercepted
+	_, err := interceptor(context.WithValue(context.TODO(), ctxKey{}, "10.0.0.1"), nil, info, handler)
+	require.NoError(t, err)
+	_, err = interceptor(context.WithValue(context.TODO(), ctxKey{}, "10.0.0.2"), nil, info, handler)
+	require.NoError(t, err)
+
+	// Then each request was charged to its own key
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, limiter.keys)
+}
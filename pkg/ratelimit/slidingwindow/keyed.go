@@ -0,0 +1,138 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slidingwindow
+
+import (
+	"sync"
+	"time"
+
+	grpc_ratelimit "github.com/RafalKorepta/most-popular-committer/pkg/ratelimit"
+)
+
+// keyedSlidingWindowLimiter keeps an independent sliding window per key
+// so every client is limited on its own budget. Windows that were not
+// used for idleTimeout are evicted to keep the registry bounded.
+type keyedSlidingWindowLimiter struct {
+	window      time.Duration
+	limit       int64
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	mu        sync.Mutex
+	windows   map[string]*keyedWindow
+	lastSweep time.Time
+}
+
+type keyedWindow struct {
+	limiter  *slidingWindowLimiter
+	lastUsed time.Time
+}
+
+// NewKeyedSlidingWindowRateLimiter creates a keyedSlidingWindowLimiter.
+// Each key gets its own window with the given length and limit, created
+// on first use and evicted after idleTimeout of inactivity.
+func NewKeyedSlidingWindowRateLimiter(window time.Duration, limit int64,
+	idleTimeout time.Duration) *keyedSlidingWindowLimiter {
+
+	return &keyedSlidingWindowLimiter{
+		window:      window,
+		limit:       limit,
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+		windows:     map[string]*keyedWindow{},
+		lastSweep:   time.Now(),
+	}
+}
+
+// WaitMaxDuration takes one token from the window of the empty key
+func (k *keyedSlidingWindowLimiter) WaitMaxDuration(maxWaitDuration time.Duration) bool {
+	return k.WaitKeyMaxDuration("", 1, maxWaitDuration)
+}
+
+// WaitNMaxDuration takes n tokens from the window of the empty key
+func (k *keyedSlidingWindowLimiter) WaitNMaxDuration(n int64, maxWaitDuration time.Duration) bool {
+	return k.WaitKeyMaxDuration("", n, maxWaitDuration)
+}
+
+// WaitKeyMaxDuration takes n tokens from the window of the given key
+// waiting up to maxWaitDuration
+func (k *keyedSlidingWindowLimiter) WaitKeyMaxDuration(key string, n int64,
+	maxWaitDuration time.Duration) bool {
+
+	return k.limiterFor(key).WaitNMaxDuration(n, maxWaitDuration)
+}
+
+// RetryAfter estimates how long a window takes to free one token. The
+// estimate is key independent, so it holds for whichever client was
+// rejected.
+func (k *keyedSlidingWindowLimiter) RetryAfter() time.Duration {
+	return k.window / time.Duration(k.limit)
+}
+
+// LimiterState reports the state aggregated over every live window, so
+// throttling can be diagnosed at runtime. Available sums the remaining
+// budget of all windows while Capacity stays the per-key limit.
+func (k *keyedSlidingWindowLimiter) LimiterState() grpc_ratelimit.State {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	state := grpc_ratelimit.State{
+		Capacity: k.limit,
+		Rate:     float64(k.limit) / k.window.Seconds(),
+		Buckets:  len(k.windows),
+	}
+	for _, w := range k.windows {
+		inner := w.limiter.LimiterState()
+		state.Available += inner.Available
+		state.Rejects += inner.Rejects
+	}
+	return state
+}
+
+// limiterFor returns the window of the given key, creating it when the
+// key is seen for the first time or was evicted in the meantime
+func (k *keyedSlidingWindowLimiter) limiterFor(key string) *slidingWindowLimiter {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.now()
+	k.sweep(now)
+
+	w, ok := k.windows[key]
+	if !ok {
+		inner := NewSlidingWindowRateLimiter(k.window, k.limit)
+		inner.now = k.now
+		w = &keyedWindow{limiter: inner}
+		k.windows[key] = w
+	}
+	w.lastUsed = now
+
+	return w.limiter
+}
+
+// sweep drops windows that were idle for longer than idleTimeout. It
+// runs at most once per idleTimeout so lookups stay cheap.
+func (k *keyedSlidingWindowLimiter) sweep(now time.Time) {
+	if k.idleTimeout <= 0 || now.Sub(k.lastSweep) < k.idleTimeout {
+		return
+	}
+	k.lastSweep = now
+
+	for key, w := range k.windows {
+		if now.Sub(w.lastUsed) >= k.idleTimeout {
+			delete(k.windows, key)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slidingwindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedSlidingWindowRateLimiter(t *testing.T) {
+	t.Run("Each key has an independent budget", func(t *testing.T) {
+		// Given a limiter whose windows admit a single token
+		limiter := NewKeyedSlidingWindowRateLimiter(time.Hour, 1, time.Hour)
+
+		// When the first client uses up its window
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+		assert.False(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+
+		// Then the second client still has its full budget
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.2", 1, 0))
+	})
+
+	t.Run("Idle window is evicted and starts fresh", func(t *testing.T) {
+		// Given a limiter whose used up window went idle for longer
+		// than the idle timeout
+		now := time.Now()
+		limiter := NewKeyedSlidingWindowRateLimiter(time.Hour, 1, time.Minute)
+		limiter.now = func() time.Time { return now }
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+		assert.False(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+
+		// When the clock passes the idle timeout
+		limiter.now = func() time.Time { return now.Add(2 * time.Minute) }
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.2", 1, 0))
+
+		// Then the idle key got a fresh window
+		assert.NotContains(t, limiter.windows, "10.0.0.1")
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+	})
+
+	t.Run("The empty key is served by its own window", func(t *testing.T) {
+		// Given a limiter whose windows admit a single token
+		limiter := NewKeyedSlidingWindowRateLimiter(time.Hour, 1, time.Hour)
+
+		// When the Limiter interface is used without a key
+		assert.True(t, limiter.WaitMaxDuration(0))
+
+		// Then follow up calls share the window of the empty key
+		assert.False(t, limiter.WaitNMaxDuration(1, 0))
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slidingwindow provides a rate limiter that caps how many
+// tokens may be taken within any rolling time window. Unlike a token
+// bucket there is no burst above the limit: a quiet period does not
+// accumulate extra budget.
+package slidingwindow
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	grpc_ratelimit "github.com/RafalKorepta/most-popular-committer/pkg/ratelimit"
+)
+
+// event records a token grab, so used budget can be recomputed as the
+// window slides past it
+type event struct {
+	at time.Time
+	n  int64
+}
+
+type slidingWindowLimiter struct {
+	window  time.Duration
+	limit   int64
+	now     func() time.Time
+	rejects int64
+
+	mu     sync.Mutex
+	events []event
+	used   int64
+}
+
+// NewSlidingWindowRateLimiter creates a limiter that admits at most
+// limit tokens within any rolling window.
+func NewSlidingWindowRateLimiter(window time.Duration, limit int64) *slidingWindowLimiter {
+	return &slidingWindowLimiter{
+		window: window,
+		limit:  limit,
+		now:    time.Now,
+	}
+}
+
+// WaitMaxDuration takes one token waiting up to maxWaitDuration
+func (s *slidingWindowLimiter) WaitMaxDuration(maxWaitDuration time.Duration) bool {
+	return s.WaitNMaxDuration(1, maxWaitDuration)
+}
+
+// WaitNMaxDuration takes n tokens waiting up to maxWaitDuration for
+// older events to leave the window
+func (s *slidingWindowLimiter) WaitNMaxDuration(n int64, maxWaitDuration time.Duration) bool {
+	if n > s.limit {
+		atomic.AddInt64(&s.rejects, 1)
+		return false
+	}
+	deadline := s.now().Add(maxWaitDuration)
+
+	for {
+		s.mu.Lock()
+		now := s.now()
+		s.prune(now)
+		if s.used+n <= s.limit {
+			s.events = append(s.events, event{at: now, n: n})
+			s.used += n
+			s.mu.Unlock()
+			return true
+		}
+		wakeAt := s.freeAt(n)
+		s.mu.Unlock()
+
+		if wakeAt.After(deadline) {
+			atomic.AddInt64(&s.rejects, 1)
+			return false
+		}
+		time.Sleep(wakeAt.Sub(now))
+	}
+}
+
+// RetryAfter estimates how long it takes until the oldest event leaves
+// the window and a token becomes available again, so rejected clients
+// can back off precisely
+func (s *slidingWindowLimiter) RetryAfter() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.prune(now)
+	if s.used < s.limit {
+		return 0
+	}
+	return s.freeAt(1).Sub(now)
+}
+
+// LimiterState reports how much of the window budget is used and how
+// many requests were turned away, so throttling can be diagnosed at
+// runtime
+func (s *slidingWindowLimiter) LimiterState() grpc_ratelimit.State {
+	s.mu.Lock()
+	s.prune(s.now())
+	available := s.limit - s.used
+	s.mu.Unlock()
+
+	return grpc_ratelimit.State{
+		Available: available,
+		Capacity:  s.limit,
+		Rate:      float64(s.limit) / s.window.Seconds(),
+		Buckets:   1,
+		Rejects:   atomic.LoadInt64(&s.rejects),
+	}
+}
+
+// prune drops the events that slid out of the window. Callers hold the
+// mutex.
+func (s *slidingWindowLimiter) prune(now time.Time) {
+	cutoff := now.Add(-s.window)
+	kept := 0
+	for _, e := range s.events {
+		if e.at.After(cutoff) {
+			break
+		}
+		s.used -= e.n
+		kept++
+	}
+	s.events = s.events[kept:]
+}
+
+// freeAt returns the earliest time at which n tokens fit into the
+// window again. Callers hold the mutex and have pruned already.
+func (s *slidingWindowLimiter) freeAt(n int64) time.Time {
+	used := s.used
+	for _, e := range s.events {
+		used -= e.n
+		if used+n <= s.limit {
+			return e.at.Add(s.window)
+		}
+	}
+	return s.now().Add(s.window)
+}
@@ -0,0 +1,92 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slidingwindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/RafalKorepta/most-popular-committer/pkg/ratelimit/tokenbucket"
+)
+
+func TestSlidingWindowRateLimiter(t *testing.T) {
+	t.Run("Requests beyond the limit are rejected within the window", func(t *testing.T) {
+		// Given a limiter admitting three tokens per window
+		now := time.Now()
+		limiter := NewSlidingWindowRateLimiter(time.Hour, 3)
+		limiter.now = func() time.Time { return now }
+
+		// When the budget is used up
+		assert.True(t, limiter.WaitNMaxDuration(2, 0))
+		assert.True(t, limiter.WaitMaxDuration(0))
+
+		// Then further requests are rejected until the window slides
+		assert.False(t, limiter.WaitMaxDuration(0))
+	})
+
+	t.Run("The budget returns when events leave the window", func(t *testing.T) {
+		// Given a limiter whose budget was used up
+		now := time.Now()
+		limiter := NewSlidingWindowRateLimiter(time.Minute, 1)
+		limiter.now = func() time.Time { return now }
+		assert.True(t, limiter.WaitMaxDuration(0))
+		assert.False(t, limiter.WaitMaxDuration(0))
+
+		// When the window slides past the recorded event
+		now = now.Add(2 * time.Minute)
+
+		// Then the full budget is available again
+		assert.True(t, limiter.WaitMaxDuration(0))
+	})
+
+	t.Run("A quiet period does not accumulate extra budget", func(t *testing.T) {
+		// Given a limiter that was idle for many windows
+		now := time.Now()
+		limiter := NewSlidingWindowRateLimiter(time.Minute, 2)
+		limiter.now = func() time.Time { return now }
+		now = now.Add(time.Hour)
+
+		// When more than the limit is requested at once
+		assert.True(t, limiter.WaitNMaxDuration(2, 0))
+
+		// Then the limit still holds, there is no saved up burst
+		assert.False(t, limiter.WaitMaxDuration(0))
+	})
+
+	t.Run("The window rejects the burst a token bucket admits", func(t *testing.T) {
+		// Given both limiter flavors configured for one request per second
+		bucket := tokenbucket.NewTokenBucketRateLimiter(time.Second, 5, 1)
+		window := NewSlidingWindowRateLimiter(time.Second, 1)
+
+		// When five requests arrive at once
+		// Then the bucket serves its whole capacity as a burst while
+		// the window strictly enforces the rate
+		assert.True(t, bucket.WaitNMaxDuration(5, 0))
+		assert.True(t, window.WaitMaxDuration(0))
+		assert.False(t, window.WaitMaxDuration(0))
+	})
+
+	t.Run("Waiting for the window to slide admits the request", func(t *testing.T) {
+		// Given a limiter with a short real time window and no budget
+		limiter := NewSlidingWindowRateLimiter(20*time.Millisecond, 1)
+		assert.True(t, limiter.WaitMaxDuration(0))
+
+		// When the caller is willing to wait for the window to slide
+		// Then the request is admitted instead of rejected
+		assert.True(t, limiter.WaitMaxDuration(2*time.Second))
+	})
+}
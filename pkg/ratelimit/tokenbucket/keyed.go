@@ -0,0 +1,137 @@
+// Copyright 2018 Zheng Dayu. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package tokenbucket
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	grpc_ratelimit "github.com/RafalKorepta/most-popular-committer/pkg/ratelimit"
+	"github.com/juju/ratelimit"
+)
+
+// keyedTokenBucketLimiter keeps an independent token bucket per key so
+// every client is limited on its own budget. Buckets that were not used
+// for idleTimeout are evicted to keep the registry bounded.
+type keyedTokenBucketLimiter struct {
+	fillInterval time.Duration
+	capacity     int64
+	quantum      int64
+	idleTimeout  time.Duration
+	now          func() time.Time
+	rejects      int64
+
+	mu        sync.Mutex
+	buckets   map[string]*keyedBucket
+	lastSweep time.Time
+}
+
+type keyedBucket struct {
+	limiter  *ratelimit.Bucket
+	lastUsed time.Time
+}
+
+// NewKeyedTokenBucketRateLimiter creates a keyedTokenBucketLimiter.
+// Each key gets its own bucket with the given fill interval, capacity
+// and quantum, created on first use and evicted after idleTimeout of
+// inactivity.
+func NewKeyedTokenBucketRateLimiter(fillInterval time.Duration, capacity, quantum int64,
+	idleTimeout time.Duration) *keyedTokenBucketLimiter {
+
+	return &keyedTokenBucketLimiter{
+		fillInterval: fillInterval,
+		capacity:     capacity,
+		quantum:      quantum,
+		idleTimeout:  idleTimeout,
+		now:          time.Now,
+		buckets:      map[string]*keyedBucket{},
+		lastSweep:    time.Now(),
+	}
+}
+
+// WaitMaxDuration takes one token from the bucket of the empty key
+func (k *keyedTokenBucketLimiter) WaitMaxDuration(maxWaitDuration time.Duration) bool {
+	return k.WaitKeyMaxDuration("", 1, maxWaitDuration)
+}
+
+// WaitNMaxDuration takes n tokens from the bucket of the empty key
+func (k *keyedTokenBucketLimiter) WaitNMaxDuration(n int64, maxWaitDuration time.Duration) bool {
+	return k.WaitKeyMaxDuration("", n, maxWaitDuration)
+}
+
+// WaitKeyMaxDuration takes n tokens from the bucket of the given key
+// waiting up to maxWaitDuration
+func (k *keyedTokenBucketLimiter) WaitKeyMaxDuration(key string, n int64,
+	maxWaitDuration time.Duration) bool {
+
+	ok := k.bucket(key).WaitMaxDuration(n, maxWaitDuration)
+	if !ok {
+		atomic.AddInt64(&k.rejects, 1)
+	}
+	return ok
+}
+
+// RetryAfter estimates how long a bucket takes to refill one token.
+// The estimate is key independent, so it holds for whichever client
+// was rejected.
+func (k *keyedTokenBucketLimiter) RetryAfter() time.Duration {
+	return k.fillInterval / time.Duration(k.quantum)
+}
+
+// LimiterState reports the state aggregated over every live bucket, so
+// throttling can be diagnosed at runtime. Available sums the tokens of
+// all buckets while Capacity stays the per-key budget.
+func (k *keyedTokenBucketLimiter) LimiterState() grpc_ratelimit.State {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	available := int64(0)
+	for _, b := range k.buckets {
+		available += b.limiter.Available()
+	}
+	return grpc_ratelimit.State{
+		Available: available,
+		Capacity:  k.capacity,
+		Rate:      float64(k.quantum) / k.fillInterval.Seconds(),
+		Buckets:   len(k.buckets),
+		Rejects:   atomic.LoadInt64(&k.rejects),
+	}
+}
+
+// bucket returns the bucket of the given key, creating it when the key
+// is seen for the first time or was evicted in the meantime
+func (k *keyedTokenBucketLimiter) bucket(key string) *ratelimit.Bucket {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.now()
+	k.sweep(now)
+
+	b, ok := k.buckets[key]
+	if !ok {
+		b = &keyedBucket{
+			limiter: ratelimit.NewBucketWithQuantum(k.fillInterval, k.capacity, k.quantum),
+		}
+		k.buckets[key] = b
+	}
+	b.lastUsed = now
+
+	return b.limiter
+}
+
+// sweep drops buckets that were idle for longer than idleTimeout. It
+// runs at most once per idleTimeout so lookups stay cheap.
+func (k *keyedTokenBucketLimiter) sweep(now time.Time) {
+	if k.idleTimeout <= 0 || now.Sub(k.lastSweep) < k.idleTimeout {
+		return
+	}
+	k.lastSweep = now
+
+	for key, b := range k.buckets {
+		if now.Sub(b.lastUsed) >= k.idleTimeout {
+			delete(k.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2018 Zheng Dayu. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package tokenbucket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedTokenBucketRateLimiter(t *testing.T) {
+	t.Run("Each key has an independent budget", func(t *testing.T) {
+		// Given a limiter whose buckets hold a single token
+		limiter := NewKeyedTokenBucketRateLimiter(time.Hour, 1, 1, time.Hour)
+
+		// When the first client drains its bucket
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+		assert.False(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+
+		// Then the second client still has its full budget
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.2", 1, 0))
+	})
+
+	t.Run("Idle bucket is evicted and starts fresh", func(t *testing.T) {
+		// Given a limiter whose drained bucket went idle for longer
+		// than the idle timeout
+		now := time.Now()
+		limiter := NewKeyedTokenBucketRateLimiter(time.Hour, 1, 1, time.Minute)
+		limiter.now = func() time.Time { return now }
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+		assert.False(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+
+		// When the clock passes the idle timeout
+		limiter.now = func() time.Time { return now.Add(2 * time.Minute) }
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.2", 1, 0))
+
+		// Then the idle key got a fresh bucket
+		assert.NotContains(t, limiter.buckets, "10.0.0.1")
+		assert.True(t, limiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+	})
+
+	t.Run("The empty key is served by its own bucket", func(t *testing.T) {
+		// Given a limiter whose buckets hold a single token
+		limiter := NewKeyedTokenBucketRateLimiter(time.Hour, 1, 1, time.Hour)
+
+		// When the Limiter interface is used without a key
+		assert.True(t, limiter.WaitMaxDuration(0))
+
+		// Then follow up calls share the bucket of the empty key
+		assert.False(t, limiter.WaitNMaxDuration(1, 0))
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright 2018 Zheng Dayu. All Rights Reserved.
+// See LICENSE for licensing terms.
+
+package tokenbucket
+
+import (
+	"sync/atomic"
+	"time"
+
+	grpc_ratelimit "github.com/RafalKorepta/most-popular-committer/pkg/ratelimit"
+	"github.com/juju/ratelimit"
+)
+
+type tokenBucketLimiter struct {
+	limiter *ratelimit.Bucket
+	rejects int64
+}
+
+// NewTokenBucketRateLimiter creates a tokenBucketLimiter.
+func NewTokenBucketRateLimiter(fillInterval time.Duration, capacity, quantum int64) *tokenBucketLimiter {
+	return &tokenBucketLimiter{
+		limiter: ratelimit.NewBucketWithQuantum(fillInterval, capacity, quantum),
+	}
+}
+
+// WaitMaxDuration
+func (b *tokenBucketLimiter) WaitMaxDuration(maxWaitDuration time.Duration) bool {
+	return b.WaitNMaxDuration(1, maxWaitDuration)
+}
+
+// WaitNMaxDuration takes n tokens from the bucket waiting up to maxWaitDuration
+func (b *tokenBucketLimiter) WaitNMaxDuration(n int64, maxWaitDuration time.Duration) bool {
+	ok := b.limiter.WaitMaxDuration(n, maxWaitDuration)
+	if !ok {
+		atomic.AddInt64(&b.rejects, 1)
+	}
+	return ok
+}
+
+// RetryAfter estimates how long it takes until the next token is
+// refilled, so rejected clients can back off precisely
+func (b *tokenBucketLimiter) RetryAfter() time.Duration {
+	missing := 1 - b.limiter.Available()
+	if missing <= 0 {
+		return 0
+	}
+	return time.Duration(float64(missing) / b.limiter.Rate() * float64(time.Second))
+}
+
+// LimiterState reports how full the bucket is and how many requests
+// were turned away, so throttling can be diagnosed at runtime
+func (b *tokenBucketLimiter) LimiterState() grpc_ratelimit.State {
+	return grpc_ratelimit.State{
+		Available: b.limiter.Available(),
+		Capacity:  b.limiter.Capacity(),
+		Rate:      b.limiter.Rate(),
+		Buckets:   1,
+		Rejects:   atomic.LoadInt64(&b.rejects),
+	}
+}
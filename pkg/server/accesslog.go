@@ -0,0 +1,68 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// accessLogHandler logs every plain HTTP request served by the mux, so
+// the REST path gets the same observability the gRPC interceptors give
+// the gRPC path.
+func accessLogHandler(logger *zap.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		aw := &accessLogResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(aw, req)
+
+		logger.Info("Finished HTTP request",
+			zap.String("method", req.Method),
+			zap.String("path", req.URL.Path),
+			zap.Int("status", aw.status),
+			zap.Int("size", aw.size),
+			zap.Duration("duration", time.Since(start)))
+	})
+}
+
+// accessLogResponseWriter records the status code and response size as
+// they pass through
+type accessLogResponseWriter struct {
+	http.ResponseWriter
+
+	status int
+	size   int
+}
+
+func (w *accessLogResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *accessLogResponseWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	w.size += n
+	return n, err
+}
+
+// Flush keeps streaming handlers, such as the SSE endpoint, working
+// behind the access log
+func (w *accessLogResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
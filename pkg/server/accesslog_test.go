@@ -0,0 +1,68 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestAccessLogHandler(t *testing.T) {
+	t.Run("Served requests are logged with their outcome", func(t *testing.T) {
+		// Given a handler behind the access log
+		core, logs := observer.New(zapcore.InfoLevel)
+		handler := accessLogHandler(zap.New(core), http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+			_, _ = w.Write([]byte("short and stout"))
+		}))
+
+		// When a request is served
+		req := httptest.NewRequest(http.MethodGet, "/v1alpha1/committer?language=go", nil)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		// Then one entry records method, path, status, size and duration
+		require.Equal(t, 1, logs.Len())
+		entry := logs.All()[0]
+		assert.Equal(t, "Finished HTTP request", entry.Message)
+		fields := entry.ContextMap()
+		assert.Equal(t, "GET", fields["method"])
+		assert.Equal(t, "/v1alpha1/committer", fields["path"])
+		assert.EqualValues(t, http.StatusTeapot, fields["status"])
+		assert.EqualValues(t, len("short and stout"), fields["size"])
+		assert.Contains(t, fields, "duration")
+	})
+
+	t.Run("A handler that never writes the header logs 200", func(t *testing.T) {
+		// Given a handler that writes nothing
+		core, logs := observer.New(zapcore.InfoLevel)
+		handler := accessLogHandler(zap.New(core), http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+
+		// When a request is served
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+		// Then the implicit OK status is logged
+		require.Equal(t, 1, logs.Len())
+		fields := logs.All()[0].ContextMap()
+		assert.EqualValues(t, http.StatusOK, fields["status"])
+		assert.EqualValues(t, 0, fields["size"])
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+)
+
+// cachedCommitterService decorates a committer service with a TTL based
+// response cache so identical requests within the TTL window do not hit
+// the GitHub API again. Expired entries are evicted lazily on access.
+type cachedCommitterService struct {
+	pb.CommitterServiceServer
+
+	ttl                    time.Duration
+	defaultMaxContributors int
+	now                    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+
+	// group deduplicates concurrent identical cache misses so a popular
+	// language triggers one upstream scan instead of a thundering herd
+	group singleflight.Group
+}
+
+type cacheEntry struct {
+	resp      *pb.CommitterResponse
+	expiresAt time.Time
+}
+
+// newCachedCommitterService wraps delegate with a response cache where
+// entries are valid for the given ttl. defaultMaxContributors has to
+// match the default configured on the decorated service so the cache
+// key reflects the served result size.
+func newCachedCommitterService(delegate pb.CommitterServiceServer, ttl time.Duration,
+	defaultMaxContributors int) *cachedCommitterService {
+
+	return &cachedCommitterService{
+		CommitterServiceServer: delegate,
+		ttl:                    ttl,
+		defaultMaxContributors: defaultMaxContributors,
+		now:                    time.Now,
+		entries:                map[string]cacheEntry{},
+	}
+}
+
+// cacheKey identifies all request properties that influence the response
+func (c *cachedCommitterService) cacheKey(req *pb.CommitterRequest) string {
+	return fmt.Sprintf("%s|%d|%s|%s|%d|%t|%s|%t|%s|%d|%d|%s|%s|%s|%s|%s|%t",
+		req.Language,
+		requestedContributors(req, c.defaultMaxContributors),
+		strings.Join(req.Organizations, ","),
+		req.IncludeAnonymous,
+		req.MinStars,
+		req.Ascending,
+		req.RepoSort,
+		req.StrictLanguage,
+		strings.Join(req.Languages, ","),
+		req.Page,
+		req.PageSize,
+		req.AnonAttribution,
+		req.Org,
+		req.PushedAfter,
+		req.PushedBefore,
+		req.ScoreMode,
+		req.ShortCircuit)
+}
+
+// InvalidateLanguage evicts every cache entry that belongs to the given
+// language and reports how many entries were removed
+func (c *cachedCommitterService) InvalidateLanguage(language string) int {
+	prefix := language + "|"
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	evicted := 0
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+			evicted++
+		}
+	}
+	return evicted
+}
+
+// MostActiveCommitter serves the response from the cache when a fresh
+// entry exists, otherwise it asks the decorated service and stores the
+// result. Requests with NoCache set always reach the decorated service.
+func (c *cachedCommitterService) MostActiveCommitter(ctx context.Context,
+	req *pb.CommitterRequest) (*pb.CommitterResponse, error) {
+
+	if req.NoCache {
+		return c.CommitterServiceServer.MostActiveCommitter(ctx, req)
+	}
+
+	key := c.cacheKey(req)
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		if c.now().Before(e.expiresAt) {
+			c.mu.Unlock()
+			cacheHits.WithLabelValues(req.Language).Inc()
+			return e.resp, nil
+		}
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	cacheMisses.WithLabelValues(req.Language).Inc()
+
+	// Concurrent identical misses share one upstream fetch and all
+	// receive its result
+	shared, err, _ := c.group.Do(key, func() (interface{}, error) {
+		resp, err := c.CommitterServiceServer.MostActiveCommitter(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.entries[key] = cacheEntry{
+			resp:      resp,
+			expiresAt: c.now().Add(c.ttl),
+		}
+		c.mu.Unlock()
+
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return shared.(*pb.CommitterResponse), nil
+}
@@ -0,0 +1,244 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+)
+
+// countingCommitterService records how often the decorated service was asked
+type countingCommitterService struct {
+	pb.CommitterServiceServer
+
+	calls int
+}
+
+func (s *countingCommitterService) MostActiveCommitter(ctx context.Context,
+	req *pb.CommitterRequest) (*pb.CommitterResponse, error) {
+
+	s.calls++
+	return &pb.CommitterResponse{
+		Language: req.Language,
+	}, nil
+}
+
+// stampedeCommitterService counts calls atomically and holds every call
+// until released, so concurrent requests can pile up on one fetch
+type stampedeCommitterService struct {
+	pb.CommitterServiceServer
+
+	calls   int64
+	release chan struct{}
+}
+
+func (s *stampedeCommitterService) MostActiveCommitter(ctx context.Context,
+	req *pb.CommitterRequest) (*pb.CommitterResponse, error) {
+
+	atomic.AddInt64(&s.calls, 1)
+	<-s.release
+	return &pb.CommitterResponse{
+		Language: req.Language,
+	}, nil
+}
+
+func TestCachedCommitterService(t *testing.T) {
+	req := &pb.CommitterRequest{
+		Language: "go",
+	}
+
+	t.Run("Identical requests within the TTL hit the backend only once", func(t *testing.T) {
+		// Given cached service
+		delegate := &countingCommitterService{}
+		srv := newCachedCommitterService(delegate, time.Minute, 0)
+
+		// When the same request is served twice
+		first, err := srv.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+		second, err := srv.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+
+		// Then the backend was asked only once
+		assert.Equal(t, 1, delegate.calls)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("Different requests do not share cache entries", func(t *testing.T) {
+		// Given cached service
+		delegate := &countingCommitterService{}
+		srv := newCachedCommitterService(delegate, time.Minute, 0)
+
+		// When two different requests are served
+		_, err := srv.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+		_, err = srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Language: "rust",
+		})
+		require.NoError(t, err)
+
+		// Then the backend was asked twice
+		assert.Equal(t, 2, delegate.calls)
+	})
+
+	t.Run("Every request field produces its own cache key", func(t *testing.T) {
+		// Given cached service and a request with every field set
+		srv := newCachedCommitterService(&countingCommitterService{}, time.Minute, 0)
+		base := func() *pb.CommitterRequest {
+			return &pb.CommitterRequest{
+				Language:         "go",
+				MaxContributors:  7,
+				Organizations:    []string{"golang"},
+				IncludeAnonymous: "true",
+				MinStars:         100,
+				Ascending:        true,
+				RepoSort:         pb.RepoSort_REPO_SORT_FORKS,
+				StrictLanguage:   true,
+				Languages:        []string{"go", "rust"},
+				Page:             2,
+				PageSize:         3,
+				AnonAttribution:  pb.AnonAttribution_ANON_ATTRIBUTION_SKIP,
+				Org:              "kubernetes",
+				PushedAfter:      "2019-01-01T00:00:00Z",
+				PushedBefore:     "2019-06-30T00:00:00Z",
+				ScoreMode:        pb.ScoreMode_SCORE_MODE_SHARE,
+				ShortCircuit:     true,
+			}
+		}
+		mutations := map[string]func(*pb.CommitterRequest){
+			"language":          func(r *pb.CommitterRequest) { r.Language = "rust" },
+			"max_contributors":  func(r *pb.CommitterRequest) { r.MaxContributors = 8 },
+			"organizations":     func(r *pb.CommitterRequest) { r.Organizations = []string{"uber"} },
+			"include_anonymous": func(r *pb.CommitterRequest) { r.IncludeAnonymous = "false" },
+			"min_stars":         func(r *pb.CommitterRequest) { r.MinStars = 200 },
+			"ascending":         func(r *pb.CommitterRequest) { r.Ascending = false },
+			"repo_sort":         func(r *pb.CommitterRequest) { r.RepoSort = pb.RepoSort_REPO_SORT_UPDATED },
+			"strict_language":   func(r *pb.CommitterRequest) { r.StrictLanguage = false },
+			"languages":         func(r *pb.CommitterRequest) { r.Languages = []string{"go"} },
+			"page":              func(r *pb.CommitterRequest) { r.Page = 3 },
+			"page_size":         func(r *pb.CommitterRequest) { r.PageSize = 4 },
+			"anon_attribution":  func(r *pb.CommitterRequest) { r.AnonAttribution = pb.AnonAttribution_ANON_ATTRIBUTION_NUMBERED },
+			"org":               func(r *pb.CommitterRequest) { r.Org = "cncf" },
+			"pushed_after":      func(r *pb.CommitterRequest) { r.PushedAfter = "2019-02-01T00:00:00Z" },
+			"pushed_before":     func(r *pb.CommitterRequest) { r.PushedBefore = "2019-07-31T00:00:00Z" },
+			"score_mode":        func(r *pb.CommitterRequest) { r.ScoreMode = pb.ScoreMode_SCORE_MODE_RAW },
+			"short_circuit":     func(r *pb.CommitterRequest) { r.ShortCircuit = false },
+		}
+
+		// When each field is changed on its own
+		for name, mutate := range mutations {
+			mutated := base()
+			mutate(mutated)
+
+			// Then the mutated request misses the cached base entry
+			assert.NotEqual(t, srv.cacheKey(base()), srv.cacheKey(mutated), name)
+		}
+	})
+
+	t.Run("Expired entries are refreshed", func(t *testing.T) {
+		// Given cached service with an artificial clock
+		delegate := &countingCommitterService{}
+		srv := newCachedCommitterService(delegate, time.Minute, 0)
+		now := time.Now()
+		srv.now = func() time.Time { return now }
+
+		// When the same request is served again after the TTL passed
+		_, err := srv.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+		now = now.Add(2 * time.Minute)
+		_, err = srv.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+
+		// Then the backend was asked twice
+		assert.Equal(t, 2, delegate.calls)
+	})
+
+	t.Run("Concurrent identical requests share one upstream fetch", func(t *testing.T) {
+		// Given cached service whose backend blocks until released
+		delegate := &stampedeCommitterService{release: make(chan struct{})}
+		srv := newCachedCommitterService(delegate, time.Minute, 0)
+
+		// When many identical requests arrive at once
+		const clients = 10
+		var wg sync.WaitGroup
+		errs := make(chan error, clients)
+		for i := 0; i < clients; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := srv.MostActiveCommitter(context.TODO(), req)
+				errs <- err
+			}()
+		}
+
+		// And the single upstream fetch finishes after they piled up
+		time.Sleep(50 * time.Millisecond)
+		close(delegate.release)
+		wg.Wait()
+		close(errs)
+
+		// Then every request succeeded on one backend call
+		for err := range errs {
+			require.NoError(t, err)
+		}
+		assert.EqualValues(t, 1, atomic.LoadInt64(&delegate.calls))
+	})
+
+	t.Run("Cache hits and misses are exported", func(t *testing.T) {
+		// Given cached service and a language no other subtest queries
+		delegate := &countingCommitterService{}
+		srv := newCachedCommitterService(delegate, time.Minute, 0)
+		metricsReq := &pb.CommitterRequest{
+			Language: "haskell",
+		}
+
+		// When the same request misses once and hits once
+		_, err := srv.MostActiveCommitter(context.TODO(), metricsReq)
+		require.NoError(t, err)
+		_, err = srv.MostActiveCommitter(context.TODO(), metricsReq)
+		require.NoError(t, err)
+
+		// Then both outcomes are counted for the language
+		assert.Equal(t, float64(1), testutil.ToFloat64(cacheMisses.WithLabelValues("haskell")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(cacheHits.WithLabelValues("haskell")))
+	})
+
+	t.Run("NoCache bypasses the cache", func(t *testing.T) {
+		// Given cached service
+		delegate := &countingCommitterService{}
+		srv := newCachedCommitterService(delegate, time.Minute, 0)
+
+		// When the same request with NoCache is served twice
+		noCacheReq := &pb.CommitterRequest{
+			Language: "go",
+			NoCache:  true,
+		}
+		_, err := srv.MostActiveCommitter(context.TODO(), noCacheReq)
+		require.NoError(t, err)
+		_, err = srv.MostActiveCommitter(context.TODO(), noCacheReq)
+		require.NoError(t, err)
+
+		// Then the backend was asked twice
+		assert.Equal(t, 2, delegate.calls)
+	})
+}
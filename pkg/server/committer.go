@@ -0,0 +1,901 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+	"github.com/google/go-github/github"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxTopRatedProjects = 5
+	maxContributors     = 10
+
+	// maxBatchConcurrency bounds how many batch entries are answered
+	// at once so a large batch cannot monopolize the GitHub quota
+	maxBatchConcurrency = 4
+
+	// maxAllowedContributors is the upper bound for the requested
+	// number of contributors
+	maxAllowedContributors = 100
+
+	// maxSearchQueryLength is the GitHub limit for the length of
+	// a single search query
+	maxSearchQueryLength = 256
+
+	// defaultMaxContributorPages bounds contributor pagination when
+	// no explicit limit was configured on the server
+	defaultMaxContributorPages = 10
+
+	// defaultMaxLanguages bounds how many languages the popular
+	// language discovery returns when the request does not say
+	defaultMaxLanguages = 10
+
+	// defaultIncludeAnonymous is the GitHub anon qualifier used when
+	// neither the request nor the server configure it. Anonymous
+	// contributors are not even fetched unless asked for
+	defaultIncludeAnonymous = "false"
+)
+
+type committerService struct {
+	logger              *zap.Logger
+	repoGetter          RepositoryGetter
+	contributorsGetter  ContributorsGetter
+	maxContributorPages int
+
+	// Instance wide defaults that replace the maxContributors and
+	// maxTopRatedProjects constants when set
+	defaultMaxContributors int
+	topRepositories        int
+
+	// includeAnonymous holds the instance wide anon qualifier,
+	// either "true" or "false". Empty means defaultIncludeAnonymous
+	includeAnonymous string
+
+	// upstreamTimeout bounds every MostActiveCommitter call against
+	// the GitHub API. No deadline is applied when zero
+	upstreamTimeout time.Duration
+
+	pb.CommitterServiceServer
+}
+
+// topRepos returns how many top rated repositories are scanned
+func (s *committerService) topRepos() int {
+	if s.topRepositories > 0 {
+		return s.topRepositories
+	}
+	return maxTopRatedProjects
+}
+
+// anonQualifier resolves the GitHub anon qualifier from the request,
+// falling back to the instance wide default
+func (s *committerService) anonQualifier(req *pb.CommitterRequest) string {
+	if req.IncludeAnonymous != "" {
+		return req.IncludeAnonymous
+	}
+	if s.includeAnonymous != "" {
+		return s.includeAnonymous
+	}
+	return defaultIncludeAnonymous
+}
+
+type RepositoryGetter interface {
+	// nolint
+	Repositories(ctx context.Context, query string, opt *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error)
+}
+
+type ContributorsGetter interface {
+	// nolint
+	ListContributors(ctx context.Context, owner string, repoName string, opt *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error)
+}
+
+// MostActiveCommitter returns list of most active committer per project which have the most github stars for
+// requested language
+func (s *committerService) MostActiveCommitter(ctx context.Context,
+	req *pb.CommitterRequest) (*pb.CommitterResponse, error) {
+
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	if s.upstreamTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.upstreamTimeout)
+		defer cancel()
+	}
+
+	for _, language := range requestedLanguages(req) {
+		committerQueries.WithLabelValues(language).Inc()
+	}
+
+	start := time.Now()
+	if len(req.Languages) == 0 {
+		merged, err := s.searchRepositories(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.collectContributors(ctx, merged, req)
+		if err != nil {
+			return nil, err
+		}
+		s.logger.Debug("MostActiveCommitter finished",
+			zap.String("language", req.Language),
+			zap.Int("contributors_found", len(resp.Contributors)),
+			zap.Duration("duration", time.Since(start)))
+		return resp, nil
+	}
+
+	resp := &pb.CommitterResponse{}
+	for _, language := range requestedLanguages(req) {
+		sub := *req
+		sub.Language = language
+		sub.Languages = nil
+
+		merged, err := s.searchRepositories(ctx, &sub)
+		if err != nil {
+			return nil, err
+		}
+		langResp, err := s.collectContributors(ctx, merged, &sub)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Results = append(resp.Results, &pb.LanguageResult{
+			Language:     language,
+			Contributors: langResp.Contributors,
+		})
+	}
+	s.logger.Debug("MostActiveCommitter finished",
+		zap.Strings("languages", requestedLanguages(req)),
+		zap.Duration("duration", time.Since(start)))
+	return resp, nil
+}
+
+// BatchMostActiveCommitter answers several committer queries in one
+// round trip by fanning out to MostActiveCommitter with bounded
+// concurrency. The entries fail independently, a failed query is
+// reported in its result instead of failing the whole batch.
+func (s *committerService) BatchMostActiveCommitter(ctx context.Context,
+	req *pb.BatchCommitterRequest) (*pb.BatchCommitterResponse, error) {
+
+	if len(req.Requests) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "At least one request needs to be provided")
+	}
+
+	results := make([]*pb.BatchCommitterResult, len(req.Requests))
+	sem := make(chan struct{}, maxBatchConcurrency)
+	var wg sync.WaitGroup
+	for i, entry := range req.Requests {
+		wg.Add(1)
+		go func(i int, entry *pb.CommitterRequest) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if entry == nil {
+				entry = &pb.CommitterRequest{}
+			}
+			resp, err := s.MostActiveCommitter(ctx, entry)
+			if err != nil {
+				st := status.Convert(err)
+				results[i] = &pb.BatchCommitterResult{
+					Code:  uint32(st.Code()),
+					Error: st.Message(),
+				}
+				return
+			}
+			results[i] = &pb.BatchCommitterResult{Response: resp}
+		}(i, entry)
+	}
+	wg.Wait()
+
+	return &pb.BatchCommitterResponse{Results: results}, nil
+}
+
+// MostActiveCommitterStream emits every committer as soon as its
+// repository is processed. Unlike MostActiveCommitter the results are
+// neither globally sorted nor truncated so clients can rank them on
+// their side and cancel early.
+func (s *committerService) MostActiveCommitterStream(req *pb.CommitterRequest,
+	stream pb.CommitterService_MostActiveCommitterStreamServer) error {
+
+	if err := validateRequest(req); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	if s.upstreamTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.upstreamTimeout)
+		defer cancel()
+	}
+
+	anon := s.anonQualifier(req)
+	perPage := requestedContributors(req, s.defaultMaxContributors)
+	for _, language := range requestedLanguages(req) {
+		committerQueries.WithLabelValues(language).Inc()
+		sub := *req
+		sub.Language = language
+		sub.Languages = nil
+
+		merged, err := s.searchRepositories(ctx, &sub)
+		if err != nil {
+			return err
+		}
+
+		for _, repo := range merged.Repositories {
+			if err := contextError(ctx); err != nil {
+				return err
+			}
+			if err := s.forEachContributor(ctx, repo, perPage, anon, req.AnonAttribution, stream.Send); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListPopularLanguages aggregates the primary languages of the top
+// starred repositories and ranks them by their combined star count, so
+// trending languages can be found before drilling into committers
+func (s *committerService) ListPopularLanguages(ctx context.Context,
+	req *pb.LanguagesRequest) (*pb.LanguagesResponse, error) {
+
+	if s.upstreamTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.upstreamTimeout)
+		defer cancel()
+	}
+
+	topRepos := int(req.TopRepositories)
+	if topRepos <= 0 {
+		topRepos = s.topRepos()
+	}
+	if topRepos > maxAllowedContributors {
+		topRepos = maxAllowedContributors
+	}
+
+	callStart := time.Now()
+	rsr, ghResp, err := s.repoGetter.Repositories(ctx, "stars:>1", &github.SearchOptions{
+		Sort:  "stars",
+		Order: "desc",
+		ListOptions: github.ListOptions{
+			Page:    0,
+			PerPage: topRepos,
+		},
+	})
+	observeRateLimit(ghResp)
+	githubAPICalls.WithLabelValues("search").Inc()
+	githubAPICallDuration.WithLabelValues("search").Observe(time.Since(callStart).Seconds())
+	if err != nil {
+		if rlErr := rateLimitExhausted(ghResp); rlErr != nil {
+			return nil, rlErr
+		}
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, status.Error(codes.DeadlineExceeded, "Timed out querying GitHub for projects")
+		}
+		s.logger.Error("Failed to query projects", zap.Error(err))
+		return nil, errorWithUpstream(codes.Internal, pb.ErrorCode_SEARCH_FAILED,
+			"", err, "Failed at finding projects")
+	}
+
+	stats := map[string]*pb.LanguageStat{}
+	for _, repo := range rsr.Repositories {
+		if repo.Language == nil || *repo.Language == "" {
+			continue
+		}
+		stat, ok := stats[*repo.Language]
+		if !ok {
+			stat = &pb.LanguageStat{Name: *repo.Language}
+			stats[*repo.Language] = stat
+		}
+		stat.Stars += uint64(repo.GetStargazersCount())
+		stat.Repositories++
+	}
+
+	resp := &pb.LanguagesResponse{}
+	for _, stat := range stats {
+		resp.Languages = append(resp.Languages, stat)
+	}
+	sort.Slice(resp.Languages, func(i, j int) bool {
+		a, b := resp.Languages[i], resp.Languages[j]
+		if a.Stars != b.Stars {
+			return a.Stars > b.Stars
+		}
+		if a.Repositories != b.Repositories {
+			return a.Repositories > b.Repositories
+		}
+		return a.Name < b.Name
+	})
+
+	maxLanguages := int(req.MaxLanguages)
+	if maxLanguages <= 0 {
+		maxLanguages = defaultMaxLanguages
+	}
+	if len(resp.Languages) > maxLanguages {
+		resp.Languages = resp.Languages[:maxLanguages]
+	}
+
+	s.logger.Debug("Aggregated popular languages",
+		zap.Int("repos_scanned", len(rsr.Repositories)),
+		zap.Int("languages_found", len(stats)),
+		zap.Duration("upstream_duration", time.Since(callStart)))
+
+	return resp, nil
+}
+
+// repoSortKeys maps the request enum onto the GitHub search sort keys
+var repoSortKeys = map[pb.RepoSort]string{
+	pb.RepoSort_REPO_SORT_STARS:              "stars",
+	pb.RepoSort_REPO_SORT_FORKS:              "forks",
+	pb.RepoSort_REPO_SORT_UPDATED:            "updated",
+	pb.RepoSort_REPO_SORT_HELP_WANTED_ISSUES: "help-wanted-issues",
+}
+
+// errorWithCode builds a status error carrying the stable error
+// category in its details so clients can branch on it
+func errorWithCode(c codes.Code, ec pb.ErrorCode, msg string) error {
+	st := status.New(c, msg)
+	withDetails, err := st.WithDetails(&pb.ErrorDetail{Code: ec})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// errorWithUpstream builds a status error that additionally carries the
+// queried language and the class of the upstream failure. The human
+// message stays generic, clients that understand the detail type can
+// react to the cause.
+func errorWithUpstream(c codes.Code, ec pb.ErrorCode, language string,
+	upstream error, msg string) error {
+
+	st := status.New(c, msg)
+	withDetails, err := st.WithDetails(&pb.ErrorDetail{
+		Code:          ec,
+		Language:      language,
+		UpstreamClass: classifyUpstreamError(upstream),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// classifyUpstreamError maps an upstream error onto a stable class, so
+// clients can branch on the cause without the status message leaking
+// upstream internals
+func classifyUpstreamError(err error) string {
+	switch e := err.(type) {
+	case nil:
+		return ""
+	case *github.RateLimitError:
+		return "rate_limit"
+	case *github.AbuseRateLimitError:
+		return "abuse_rate_limit"
+	case *github.ErrorResponse:
+		if e.Response != nil && e.Response.StatusCode >= http.StatusInternalServerError {
+			return "github_server"
+		}
+		return "github_client"
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return "network"
+	}
+	return "unknown"
+}
+
+// validateRequest rejects requests that can not be served
+func validateRequest(req *pb.CommitterRequest) error {
+	if len(requestedLanguages(req)) == 0 && req.Org == "" && len(req.Organizations) == 0 {
+		return errorWithCode(codes.InvalidArgument, pb.ErrorCode_INVALID_LANGUAGE,
+			"At least one language or organization needs to be provided")
+	}
+
+	if req.IncludeAnonymous != "" && req.IncludeAnonymous != "true" && req.IncludeAnonymous != "false" {
+		return status.Error(codes.InvalidArgument, `include_anonymous accepts only "true" or "false"`)
+	}
+
+	if _, ok := repoSortKeys[req.RepoSort]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Unknown repository sort %d", req.RepoSort)
+	}
+
+	switch req.ScoreMode {
+	case pb.ScoreMode_SCORE_MODE_RAW, pb.ScoreMode_SCORE_MODE_SHARE:
+	default:
+		return status.Errorf(codes.InvalidArgument, "Unknown score mode %d", req.ScoreMode)
+	}
+
+	switch req.AnonAttribution {
+	case pb.AnonAttribution_ANON_ATTRIBUTION_NUMBERED, pb.AnonAttribution_ANON_ATTRIBUTION_SKIP:
+	case pb.AnonAttribution_ANON_ATTRIBUTION_BY_EMAIL, pb.AnonAttribution_ANON_ATTRIBUTION_BY_NAME:
+		// The pinned GitHub client drops the email and name the API
+		// reports for anonymous entries, so there is nothing to
+		// attribute them by yet
+		return status.Errorf(codes.Unimplemented,
+			"Anonymous attribution %s is not served yet, the GitHub client does not decode the identity of anonymous entries",
+			req.AnonAttribution)
+	default:
+		return status.Errorf(codes.InvalidArgument, "Unknown anonymous attribution %d", req.AnonAttribution)
+	}
+
+	return nil
+}
+
+// searchRepositories runs every composed search query and merges the
+// found repositories
+func (s *committerService) searchRepositories(ctx context.Context,
+	req *pb.CommitterRequest) (*github.RepositoriesSearchResult, error) {
+
+	queries, err := searchQueries(req)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	merged := &github.RepositoriesSearchResult{}
+	totalRepositories := 0
+	incompleteResults := false
+	for _, query := range queries {
+		callStart := time.Now()
+		rsr, ghResp, err := s.repoGetter.Repositories(ctx, query, &github.SearchOptions{
+			Sort:  repoSortKeys[req.RepoSort],
+			Order: "desc",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: s.topRepos(),
+			},
+		})
+		observeRateLimit(ghResp)
+		githubAPICalls.WithLabelValues("search").Inc()
+		githubAPICallDuration.WithLabelValues("search").Observe(time.Since(callStart).Seconds())
+		if err != nil {
+			if rlErr := rateLimitExhausted(ghResp); rlErr != nil {
+				return nil, rlErr
+			}
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, status.Error(codes.DeadlineExceeded, "Timed out querying GitHub for projects")
+			}
+			s.logger.Error("Failed to query projects", zap.Error(err))
+			return nil, errorWithUpstream(codes.Internal, pb.ErrorCode_SEARCH_FAILED,
+				req.Language, err, "Failed at finding projects")
+		}
+		merged.Repositories = append(merged.Repositories, rsr.Repositories...)
+		totalRepositories += rsr.GetTotal()
+		incompleteResults = incompleteResults || rsr.GetIncompleteResults()
+	}
+	// Carry how big the search actually was so clients know the scanned
+	// repositories are only a sample of the top rated ones
+	merged.Total = &totalRepositories
+	merged.IncompleteResults = &incompleteResults
+
+	if req.StrictLanguage && req.Language != "" {
+		// Search also matches repositories that merely contain the
+		// language, keep only those GitHub classifies under it
+		kept := merged.Repositories[:0]
+		for _, repo := range merged.Repositories {
+			if repo.Language != nil && strings.EqualFold(*repo.Language, req.Language) {
+				kept = append(kept, repo)
+			}
+		}
+		merged.Repositories = kept
+	}
+
+	s.logger.Debug("Retrieved repositories",
+		zap.String("language", req.Language),
+		zap.Int("repos_scanned", len(merged.Repositories)),
+		zap.Duration("upstream_duration", time.Since(start)),
+		zap.Any("repositories list", merged))
+
+	return merged, nil
+}
+
+// validOrgName matches GitHub user and organization names
+var validOrgName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{0,38}$`)
+
+// searchQueries composes the GitHub search queries for the request.
+// Every organization adds an org: qualifier to the query. When the
+// composed query would exceed the GitHub query length limit it is
+// split into multiple searches which are merged by the caller.
+func searchQueries(req *pb.CommitterRequest) ([]string, error) {
+	var base string
+	if req.Language != "" {
+		base = "language:" + req.Language
+	}
+	if req.Org != "" {
+		if !validOrgName.MatchString(req.Org) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid organization name %q", req.Org)
+		}
+		if base != "" {
+			base += " "
+		}
+		base += "org:" + req.Org
+	}
+	if req.MinStars > 0 {
+		// Filtering in the search query itself keeps repositories
+		// below the threshold from wasting the page budget
+		base += fmt.Sprintf(" stars:>=%d", req.MinStars)
+	}
+	pushed, err := pushedQualifier(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// An organizations only request starts with no base query, the
+	// qualifiers must not leave a leading space behind
+	base = strings.TrimSpace(base + pushed)
+
+	var queries []string
+	current := base
+	for _, org := range req.Organizations {
+		if !validOrgName.MatchString(org) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid organization name %q", org)
+		}
+
+		qualifier := " org:" + org
+		if len(current)+len(qualifier) > maxSearchQueryLength {
+			queries = append(queries, current)
+			current = base
+		}
+		if current == "" {
+			// An organizations only request has no base query to extend
+			current = "org:" + org
+		} else {
+			current += qualifier
+		}
+	}
+
+	return append(queries, current), nil
+}
+
+// pushedQualifier translates the pushed_after and pushed_before bounds
+// of the request into GitHub pushed: search qualifiers. It is empty
+// when neither bound is set so the search stays unchanged.
+func pushedQualifier(req *pb.CommitterRequest) (string, error) {
+	var after, before time.Time
+	if req.PushedAfter != "" {
+		t, err := time.Parse(time.RFC3339, req.PushedAfter)
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "pushed_after is not an RFC3339 timestamp: %v", err)
+		}
+		after = t
+	}
+	if req.PushedBefore != "" {
+		t, err := time.Parse(time.RFC3339, req.PushedBefore)
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "pushed_before is not an RFC3339 timestamp: %v", err)
+		}
+		before = t
+	}
+	if !after.IsZero() && !before.IsZero() && after.After(before) {
+		return "", status.Error(codes.InvalidArgument, "pushed_after must not be later than pushed_before")
+	}
+
+	var qualifier string
+	if !after.IsZero() {
+		qualifier += " pushed:>=" + after.Format("2006-01-02")
+	}
+	if !before.IsZero() {
+		qualifier += " pushed:<=" + before.Format("2006-01-02")
+	}
+	return qualifier, nil
+}
+
+// contextError converts a finished context into the matching status
+// error, so a cancelled client stops driving GitHub calls for the
+// remaining repositories. It returns nil while the context is alive
+func contextError(ctx context.Context) error {
+	switch ctx.Err() {
+	case nil:
+		return nil
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, "Timed out querying GitHub")
+	default:
+		return status.Error(codes.Canceled, "Request cancelled")
+	}
+}
+
+// rateLimitExhausted converts a GitHub response with no remaining quota
+// to a ResourceExhausted status that tells the caller when the limit
+// resets. It returns nil when there is quota left or the response does
+// not carry rate information.
+func rateLimitExhausted(resp *github.Response) error {
+	if resp == nil || resp.Rate.Limit == 0 || resp.Rate.Remaining > 0 {
+		return nil
+	}
+	return errorWithCode(codes.ResourceExhausted, pb.ErrorCode_RATE_LIMITED,
+		fmt.Sprintf("GitHub rate limit exhausted, resets at %s", resp.Rate.Reset.Format(time.RFC3339)))
+}
+
+// requestedLanguages returns every language the request asks for. The
+// single language field comes first so its ranking keeps the leading
+// position in multi language responses.
+func requestedLanguages(req *pb.CommitterRequest) []string {
+	languages := make([]string, 0, len(req.Languages)+1)
+	if req.Language != "" {
+		languages = append(languages, req.Language)
+	}
+	for _, l := range req.Languages {
+		if l != "" {
+			languages = append(languages, l)
+		}
+	}
+	return languages
+}
+
+// requestedContributors returns the number of contributors that should be
+// returned. It falls back to def, or to maxContributors when def is not
+// set, when the request does not set the limit and clamps the result to
+// maxAllowedContributors.
+func requestedContributors(req *pb.CommitterRequest, def int) int {
+	if def <= 0 {
+		def = maxContributors
+	}
+	switch c := int(req.MaxContributors); {
+	case c <= 0:
+		return def
+	case c > maxAllowedContributors:
+		return maxAllowedContributors
+	default:
+		return c
+	}
+}
+
+func (s *committerService) collectContributors(ctx context.Context, r *github.RepositoriesSearchResult,
+	req *pb.CommitterRequest) (*pb.CommitterResponse, error) {
+
+	resp := &pb.CommitterResponse{
+		Language:          req.Language,
+		TotalRepositories: uint64(r.GetTotal()),
+		IncompleteResults: r.GetIncompleteResults(),
+	}
+
+	maxC := requestedContributors(req, s.defaultMaxContributors)
+	anon := s.anonQualifier(req)
+
+	collectStart := time.Now()
+	distinct := map[string]bool{}
+	for _, repo := range r.Repositories {
+		if err := contextError(ctx); err != nil {
+			return nil, err
+		}
+		if req.ShortCircuit && len(distinct) >= maxC {
+			// The requested number of distinct committers was already
+			// collected, the remaining repositories are skipped to
+			// save GitHub calls
+			s.logger.Debug("Short circuiting the repository scan",
+				zap.Int("contributors_collected", len(distinct)),
+				zap.Int("max_contributors", maxC))
+			break
+		}
+		err := s.forEachContributor(ctx, repo, maxC, anon, req.AnonAttribution, func(c *pb.Committer) error {
+			resp.Contributors = append(resp.Contributors, c)
+			distinct[c.Name] = true
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	scoreContributors(resp.Contributors, req.ScoreMode)
+
+	// The whole collected set is sorted before paginating so the
+	// ordering stays stable across pages
+	sort.Slice(resp.Contributors, func(i, j int) bool {
+		a, b := resp.Contributors[i], resp.Contributors[j]
+		if req.ScoreMode == pb.ScoreMode_SCORE_MODE_SHARE && a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Commits > b.Commits
+	})
+
+	total := len(resp.Contributors)
+	resp.TotalCount = uint32(total)
+	committerContributorsReturned.Observe(float64(total))
+
+	s.logger.Debug("Collected contributors",
+		zap.String("language", req.Language),
+		zap.Int("repos_scanned", len(r.Repositories)),
+		zap.Int("contributors_found", total),
+		zap.Duration("upstream_duration", time.Since(collectStart)))
+
+	pageSize := maxC
+	if ps := int(req.PageSize); ps > 0 {
+		pageSize = ps
+		if pageSize > maxAllowedContributors {
+			pageSize = maxAllowedContributors
+		}
+	}
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+
+	start := (page - 1) * pageSize
+	end := start + pageSize
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+	resp.Contributors = resp.Contributors[start:end]
+	if end < total {
+		resp.NextPage = uint32(page + 1)
+	}
+
+	// The top rated committers are found by the descending sort above,
+	// the reversal only changes how they are presented
+	if req.Ascending {
+		for i, j := 0, len(resp.Contributors)-1; i < j; i, j = i+1, j-1 {
+			resp.Contributors[i], resp.Contributors[j] = resp.Contributors[j], resp.Contributors[i]
+		}
+	}
+
+	return resp, nil
+}
+
+// scoreContributors computes the score committers are ranked by in the
+// share mode. The score weights the commits against the total collected
+// contributions of the committer's repository, so committers of huge
+// repositories do not dominate the ranking by raw volume. The raw mode
+// ranks by the plain commit count and leaves the score unset.
+func scoreContributors(contributors []*pb.Committer, mode pb.ScoreMode) {
+	if mode != pb.ScoreMode_SCORE_MODE_SHARE {
+		return
+	}
+
+	// The repository metadata is shared per repository, so the pointer
+	// identifies the repository a committer was found in
+	totals := map[*pb.Repository]uint64{}
+	for _, c := range contributors {
+		totals[c.Repository] += c.Commits
+	}
+	for _, c := range contributors {
+		if total := totals[c.Repository]; total > 0 {
+			c.Score = float64(c.Commits) / float64(total)
+		}
+	}
+}
+
+// forEachContributor pages through the contributors of a repository and
+// hands every one of them to fn. It stops early when fn returns an error.
+func (s *committerService) forEachContributor(ctx context.Context, repo github.Repository,
+	perPage int, anon string, attribution pb.AnonAttribution, fn func(*pb.Committer) error) error {
+
+	// The search API may return partially populated repositories, a
+	// missing owner or name must not panic the whole server
+	if repo.Owner == nil || repo.Owner.Login == nil || repo.Name == nil {
+		s.logger.Warn("Skipping repository without an owner or a name",
+			zap.String("repository", repo.GetFullName()))
+		return nil
+	}
+
+	maxPages := s.maxContributorPages
+	if maxPages <= 0 {
+		maxPages = defaultMaxContributorPages
+	}
+
+	// The metadata is shared by every committer of the repository
+	repoMeta := &pb.Repository{
+		Name:            repo.GetName(),
+		PrimaryLanguage: repo.GetLanguage(),
+		FullName:        repo.GetFullName(),
+		Stars:           uint64(repo.GetStargazersCount()),
+		HtmlUrl:         repo.GetHTMLURL(),
+	}
+
+	page := 0
+	anonCount := 0
+	// Contributors are deduplicated by name because the page boundaries
+	// can shift while paging, which would count a committer twice
+	seen := map[string]bool{}
+	for fetchedPages := 0; ; {
+		callStart := time.Now()
+		contributors, ghResp, err := s.contributorsGetter.ListContributors(
+			ctx,
+			*repo.Owner.Login,
+			*repo.Name,
+			&github.ListContributorsOptions{
+				Anon: anon,
+				ListOptions: github.ListOptions{
+					Page:    page,
+					PerPage: perPage,
+				},
+			})
+		observeRateLimit(ghResp)
+		githubAPICalls.WithLabelValues("contributors").Inc()
+		githubAPICallDuration.WithLabelValues("contributors").Observe(time.Since(callStart).Seconds())
+		if err != nil {
+			if rlErr := rateLimitExhausted(ghResp); rlErr != nil {
+				return rlErr
+			}
+			if ctx.Err() == context.DeadlineExceeded {
+				return status.Error(codes.DeadlineExceeded, "Timed out querying GitHub for contributors")
+			}
+			s.logger.Error("Failed to query contributors", zap.Error(err))
+			return errorWithUpstream(codes.Internal, pb.ErrorCode_CONTRIBUTORS_FAILED,
+				repo.GetLanguage(), err, "Failed at finding contributors")
+		}
+
+		for _, c := range contributors {
+			var name string
+			switch {
+			case c.Login != nil:
+				name = *c.Login
+			case anon != "true":
+				continue
+			case attribution == pb.AnonAttribution_ANON_ATTRIBUTION_SKIP:
+				continue
+			default:
+				// The GitHub client does not decode the name and
+				// email the API reports for anonymous entries, so
+				// they are numbered per repository instead
+				anonCount++
+				name = fmt.Sprintf("anonymous-%d", anonCount)
+			}
+
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			var commits uint64
+			if c.Contributions != nil {
+				commits = uint64(*c.Contributions)
+			} else {
+				s.logger.Debug("Contributor without a contribution count",
+					zap.String("name", name))
+			}
+
+			if err := fn(&pb.Committer{
+				Name:       name,
+				Commits:    commits,
+				Repository: repoMeta,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if ghResp == nil || ghResp.NextPage == 0 {
+			break
+		}
+
+		fetchedPages++
+		if fetchedPages >= maxPages {
+			s.logger.Warn("Reached the contributor page limit",
+				zap.String("repository", *repo.Name),
+				zap.Int("maxContributorPages", maxPages))
+			break
+		}
+		page = ghResp.NextPage
+	}
+	return nil
+}
@@ -0,0 +1,2532 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/google/go-github/github"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+)
+
+type mockRepoGetter struct {
+	mock.Mock
+}
+
+func (m *mockRepoGetter) Repositories(ctx context.Context, query string,
+	opt *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+
+	args := m.Called(ctx, query, opt)
+	result, ok := args.Get(0).(*github.RepositoriesSearchResult)
+	if !ok {
+		return nil, nil, args.Error(0)
+	}
+	return result, nil, nil
+}
+
+type mockContGetter struct {
+	mock.Mock
+}
+
+func (m *mockContGetter) ListContributors(ctx context.Context, owner string, repoName string,
+	opt *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error) {
+
+	args := m.Called(ctx, owner, repoName, opt)
+	result, ok := args.Get(0).([]*github.Contributor)
+	if !ok {
+		return nil, nil, args.Error(0)
+	}
+	return result, nil, nil
+}
+
+func TestCommitterService_MostActiveCommitter(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("Failed when language is not provided", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Language: "",
+		})
+
+		// Then an error is returned
+		require.Error(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("Failed when repositories query filed", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And mocked repository getter
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:"+req.Language, &github.SearchOptions{
+			Sort:  "stars",
+			Order: "desc",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 5,
+			},
+		}).Return(errors.New("test error"))
+
+		// And service with repoGetter
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rg,
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then an error is returned
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "Failed at finding projects")
+	})
+
+	t.Run("Failed when committer query filed", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And mocked contributor getter
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "false",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 10,
+			},
+		}).Return(errors.New("test error"))
+
+		// And service with repoGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then an error is returned
+		require.Error(t, err)
+
+		assert.Contains(t, err.Error(), "Failed at finding contributors")
+	})
+
+	t.Run("Return empty response when no repository found", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And mocked repository getter
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:"+req.Language, &github.SearchOptions{
+			Sort:  "stars",
+			Order: "desc",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 5,
+			},
+		}).Return(&github.RepositoriesSearchResult{
+			Repositories: nil,
+		})
+
+		// And service with repoGetter
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		assert.Len(t, resp.Contributors, 0)
+		assert.Equal(t, req.Language, resp.Language)
+	})
+
+	t.Run("Return empty response when no contributors found", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And mocked repository getter
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "false",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 10,
+			},
+		}).Return(nil)
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		assert.Len(t, resp.Contributors, 0)
+		assert.Equal(t, req.Language, resp.Language)
+	})
+
+	t.Run("Return valid response", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: contributorGetterSetup(ctx, testUser, testRepo, 1),
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		assert.Equal(t, &pb.CommitterResponse{
+			Language: "testlanguage",
+			Contributors: []*pb.Committer{
+				{
+					Name:       "test user",
+					Commits:    1,
+					Repository: &pb.Repository{Name: "test repo"},
+				},
+			},
+			TotalCount: 1,
+		}, resp)
+	})
+
+	t.Run("Handle contributor without a contribution count", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And mocked contributor getter that returns a contributor with
+		// a login but no contribution count
+		login := "test user"
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "false",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 10,
+			},
+		}).Return([]*github.Contributor{
+			{
+				Login:         &login,
+				Contributions: nil,
+			},
+		})
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned and the contributor counts as zero commits
+		require.NoError(t, err)
+		assert.Equal(t, []*pb.Committer{
+			{
+				Name:       "test user",
+				Commits:    0,
+				Repository: &pb.Repository{Name: "test repo"},
+			},
+		}, resp.Contributors)
+	})
+
+	t.Run("Ascending order still truncates to the most active committers", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that asks for the top 2 contributors ascending
+		req := &pb.CommitterRequest{
+			Language:        "testlanguage",
+			MaxContributors: 2,
+			Ascending:       true,
+		}
+
+		// And mocked contributor getter that returns more contributors than requested
+		first, second, third := "first", "second", "third"
+		oneCommit, twoCommits, threeCommits := 1, 2, 3
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &first,
+				Contributions: &oneCommit,
+			},
+			{
+				Login:         &second,
+				Contributions: &threeCommits,
+			},
+			{
+				Login:         &third,
+				Contributions: &twoCommits,
+			},
+		})
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And the top 2 contributors are returned least active first
+		assert.Equal(t, []*pb.Committer{
+			{
+				Name:       "third",
+				Commits:    2,
+				Repository: &pb.Repository{Name: "test repo"},
+			},
+			{
+				Name:       "second",
+				Commits:    3,
+				Repository: &pb.Repository{Name: "test repo"},
+			},
+		}, resp.Contributors)
+	})
+
+	t.Run("Respect requested number of contributors", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that asks for top 2 contributors
+		req := &pb.CommitterRequest{
+			Language:        "testlanguage",
+			MaxContributors: 2,
+		}
+
+		// And mocked contributor getter that returns more contributors than requested
+		first, second, third := "first", "second", "third"
+		oneCommit, twoCommits, threeCommits := 1, 2, 3
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "false",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 2,
+			},
+		}).Return([]*github.Contributor{
+			{
+				Login:         &first,
+				Contributions: &oneCommit,
+			},
+			{
+				Login:         &second,
+				Contributions: &threeCommits,
+			},
+			{
+				Login:         &third,
+				Contributions: &twoCommits,
+			},
+		})
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And only the top 2 contributors are returned
+		assert.Equal(t, []*pb.Committer{
+			{
+				Name:       "second",
+				Commits:    3,
+				Repository: &pb.Repository{Name: "test repo"},
+			},
+			{
+				Name:       "third",
+				Commits:    2,
+				Repository: &pb.Repository{Name: "test repo"},
+			},
+		}, resp.Contributors)
+	})
+}
+
+// recordingCommitterStream collects everything sent on the stream
+type recordingCommitterStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	sent []*pb.Committer
+}
+
+func (s *recordingCommitterStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingCommitterStream) Send(c *pb.Committer) error {
+	s.sent = append(s.sent, c)
+	return nil
+}
+
+func TestCommitterService_MostActiveCommitterStream(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("Committers are streamed per repository", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: contributorGetterSetup(ctx, testUser, testRepo, 1),
+		}
+
+		// When the streaming variant is called
+		stream := &recordingCommitterStream{ctx: ctx}
+		err := srv.MostActiveCommitterStream(req, stream)
+
+		// Then no error is returned and the committer was streamed
+		require.NoError(t, err)
+		assert.Equal(t, []*pb.Committer{
+			{
+				Name:       "test user",
+				Commits:    1,
+				Repository: &pb.Repository{Name: "test repo"},
+			},
+		}, stream.sent)
+	})
+
+	t.Run("Failed when language is not provided", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When the streaming variant is called without a language
+		err := srv.MostActiveCommitterStream(&pb.CommitterRequest{}, &recordingCommitterStream{
+			ctx: context.TODO(),
+		})
+
+		// Then an error is returned
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCommitterService_ServerDefaults(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("Server defaults drive search options and truncation", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request without explicit limits
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And mocked repository getter expecting the configured repository count
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:"+req.Language, &github.SearchOptions{
+			Sort:  "stars",
+			Order: "desc",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 2,
+			},
+		}).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner: &github.User{
+						Login: &testUser,
+					},
+					Name: &testRepo,
+				},
+			},
+		})
+
+		// And mocked contributor getter expecting the configured contributor count
+		logins := []string{"first", "second", "third", "fourth"}
+		commits := []int{4, 3, 2, 1}
+		var contributors []*github.Contributor
+		for i := range logins {
+			contributors = append(contributors, &github.Contributor{
+				Login:         &logins[i],
+				Contributions: &commits[i],
+			})
+		}
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "false",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 3,
+			},
+		}).Return(contributors)
+
+		// And service with instance wide defaults
+		srv := &committerService{
+			logger:                 zap.L(),
+			repoGetter:             rg,
+			contributorsGetter:     cg,
+			defaultMaxContributors: 3,
+			topRepositories:        2,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And the response is truncated to the configured default
+		assert.Len(t, resp.Contributors, 3)
+	})
+
+	t.Run("Request limit overrides the server default", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request asking for fewer contributors than the default
+		req := &pb.CommitterRequest{
+			Language:        "testlanguage",
+			MaxContributors: 2,
+		}
+
+		// And mocked contributor getter expecting the requested count
+		logins := []string{"first", "second", "third"}
+		commits := []int{3, 2, 1}
+		var contributors []*github.Contributor
+		for i := range logins {
+			contributors = append(contributors, &github.Contributor{
+				Login:         &logins[i],
+				Contributions: &commits[i],
+			})
+		}
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "false",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 2,
+			},
+		}).Return(contributors)
+
+		// And service with a bigger instance wide default
+		srv := &committerService{
+			logger:                 zap.L(),
+			repoGetter:             repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter:     cg,
+			defaultMaxContributors: 5,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And the response is truncated to the requested limit
+		assert.Len(t, resp.Contributors, 2)
+		cg.AssertExpectations(t)
+	})
+}
+
+func TestCommitterService_AnonDefault(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	contGetterExpectingAnon := func(ctx context.Context, anon string) *mockContGetter {
+		contributions := 1
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: anon,
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 10,
+			},
+		}).Return([]*github.Contributor{
+			{
+				Login:         &testUser,
+				Contributions: &contributions,
+			},
+		})
+		return cg
+	}
+
+	t.Run("Server default applies when the request is silent", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request without the anonymous preference
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And mocked contributor getter expecting the server default
+		cg := contGetterExpectingAnon(ctx, "false")
+
+		// And service that excludes anonymous contributors by default
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+			includeAnonymous:   "false",
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned and the mock expectation was met
+		require.NoError(t, err)
+		cg.AssertExpectations(t)
+	})
+
+	t.Run("Request overrides the server default", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that asks for anonymous contributors
+		req := &pb.CommitterRequest{
+			Language:         "testlanguage",
+			IncludeAnonymous: "true",
+		}
+
+		// And mocked contributor getter expecting the request preference
+		cg := contGetterExpectingAnon(ctx, "true")
+
+		// And service that excludes anonymous contributors by default
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+			includeAnonymous:   "false",
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned and the mock expectation was met
+		require.NoError(t, err)
+		cg.AssertExpectations(t)
+	})
+
+	t.Run("Invalid anonymous preference is rejected", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When most active committer is called with a malformed preference
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Language:         "testlanguage",
+			IncludeAnonymous: "maybe",
+		})
+
+		// Then an error is returned
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "include_anonymous")
+	})
+}
+
+func TestCommitterService_AnonymousContributors(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("Included anonymous contributors are counted under a synthetic name", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that asks for anonymous contributors
+		req := &pb.CommitterRequest{
+			Language:         "testlanguage",
+			IncludeAnonymous: "true",
+		}
+
+		// And mocked contributor getter returning a named and an
+		// anonymous contributor
+		named := 3
+		anonymous := 5
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "true",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 10,
+			},
+		}).Return([]*github.Contributor{
+			{
+				Login:         &testUser,
+				Contributions: &named,
+			},
+			{
+				Contributions: &anonymous,
+			},
+		})
+
+		// And service
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And the anonymous contributions rank above the named ones
+		require.Len(t, resp.Contributors, 2)
+		assert.Equal(t, "anonymous-1", resp.Contributors[0].Name)
+		assert.Equal(t, uint64(5), resp.Contributors[0].Commits)
+		assert.Equal(t, testUser, resp.Contributors[1].Name)
+	})
+
+	t.Run("Anonymous contributors without a login are dropped when excluded", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that keeps the default exclusion
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And mocked contributor getter that returns an anonymous
+		// contributor anyway
+		anonymous := 5
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "false",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 10,
+			},
+		}).Return([]*github.Contributor{
+			{
+				Contributions: &anonymous,
+			},
+		})
+
+		// And service
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned and the anonymous entry is skipped
+		require.NoError(t, err)
+		assert.Empty(t, resp.Contributors)
+	})
+
+	t.Run("Skip attribution drops fetched anonymous contributors", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that fetches anonymous contributors but
+		// attributes them with the skip strategy
+		req := &pb.CommitterRequest{
+			Language:         "testlanguage",
+			IncludeAnonymous: "true",
+			AnonAttribution:  pb.AnonAttribution_ANON_ATTRIBUTION_SKIP,
+		}
+
+		// And mocked contributor getter returning a named and an
+		// anonymous contributor
+		named := 3
+		anonymous := 5
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, &github.ListContributorsOptions{
+			Anon: "true",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 10,
+			},
+		}).Return([]*github.Contributor{
+			{
+				Login:         &testUser,
+				Contributions: &named,
+			},
+			{
+				Contributions: &anonymous,
+			},
+		})
+
+		// And service
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then only the named contributor is returned
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 1)
+		assert.Equal(t, testUser, resp.Contributors[0].Name)
+	})
+
+	t.Run("Attribution by email is not served yet", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When most active committer asks for the email attribution
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Language:         "testlanguage",
+			IncludeAnonymous: "true",
+			AnonAttribution:  pb.AnonAttribution_ANON_ATTRIBUTION_BY_EMAIL,
+		})
+
+		// Then the request is rejected as unimplemented
+		require.Error(t, err)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestCommitterService_StrictLanguage(t *testing.T) {
+	testUser := "test user"
+	matchingRepo := "matching repo"
+	mismatchedRepo := "mismatched repo"
+	goLang := "Go"
+	rustLang := "Rust"
+
+	repoGetterWithLanguages := func(ctx context.Context) RepositoryGetter {
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner:    &github.User{Login: &testUser},
+					Name:     &matchingRepo,
+					Language: &goLang,
+				},
+				{
+					Owner:    &github.User{Login: &testUser},
+					Name:     &mismatchedRepo,
+					Language: &rustLang,
+				},
+			},
+		})
+		return rg
+	}
+
+	t.Run("Primary language is surfaced in the repository metadata", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "go",
+		}
+
+		// And mocked contributor getter serving both repositories
+		contributions := 1
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, mock.Anything, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &testUser,
+				Contributions: &contributions,
+			},
+		})
+
+		// And service
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repoGetterWithLanguages(ctx),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And every committer carries its repository's primary language
+		require.Len(t, resp.Contributors, 2)
+		assert.Equal(t, goLang, resp.Contributors[0].Repository.PrimaryLanguage)
+		assert.Equal(t, rustLang, resp.Contributors[1].Repository.PrimaryLanguage)
+	})
+
+	t.Run("Strict mode drops repositories with a mismatched language", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that enforces the primary language
+		req := &pb.CommitterRequest{
+			Language:       "go",
+			StrictLanguage: true,
+		}
+
+		// And mocked contributor getter that only expects the matching repository
+		contributions := 1
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, matchingRepo, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &testUser,
+				Contributions: &contributions,
+			},
+		})
+
+		// And service
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repoGetterWithLanguages(ctx),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And only the matching repository was scanned
+		require.Len(t, resp.Contributors, 1)
+		assert.Equal(t, matchingRepo, resp.Contributors[0].Repository.Name)
+		cg.AssertExpectations(t)
+	})
+}
+
+func TestSearchQueries(t *testing.T) {
+	t.Run("Language only", func(t *testing.T) {
+		queries, err := searchQueries(&pb.CommitterRequest{Language: "go"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"language:go"}, queries)
+	})
+
+	t.Run("Minimum star count becomes a stars qualifier", func(t *testing.T) {
+		queries, err := searchQueries(&pb.CommitterRequest{
+			Language: "go",
+			MinStars: 1000,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"language:go stars:>=1000"}, queries)
+	})
+
+	t.Run("Org only", func(t *testing.T) {
+		queries, err := searchQueries(&pb.CommitterRequest{Org: "testorg"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"org:testorg"}, queries)
+	})
+
+	t.Run("Org combines with the language", func(t *testing.T) {
+		queries, err := searchQueries(&pb.CommitterRequest{
+			Language: "go",
+			Org:      "testorg",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"language:go org:testorg"}, queries)
+	})
+
+	t.Run("Invalid org is rejected", func(t *testing.T) {
+		_, err := searchQueries(&pb.CommitterRequest{Org: "not a valid org"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid organization name")
+	})
+
+	t.Run("Organizations compose into a single query", func(t *testing.T) {
+		queries, err := searchQueries(&pb.CommitterRequest{
+			Language:      "go",
+			Organizations: []string{"orga", "orgb"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"language:go org:orga org:orgb"}, queries)
+	})
+
+	t.Run("Organizations only", func(t *testing.T) {
+		queries, err := searchQueries(&pb.CommitterRequest{
+			Organizations: []string{"orga", "orgb"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"org:orga org:orgb"}, queries)
+	})
+
+	t.Run("Pushed bounds become pushed qualifiers", func(t *testing.T) {
+		queries, err := searchQueries(&pb.CommitterRequest{
+			Language:     "go",
+			PushedAfter:  "2019-01-01T00:00:00Z",
+			PushedBefore: "2019-06-30T00:00:00Z",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"language:go pushed:>=2019-01-01 pushed:<=2019-06-30"}, queries)
+	})
+
+	t.Run("A single pushed bound stands on its own", func(t *testing.T) {
+		queries, err := searchQueries(&pb.CommitterRequest{
+			Language:    "go",
+			PushedAfter: "2019-01-01T00:00:00Z",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"language:go pushed:>=2019-01-01"}, queries)
+	})
+
+	t.Run("A pushed bound that is not RFC3339 is rejected", func(t *testing.T) {
+		_, err := searchQueries(&pb.CommitterRequest{
+			Language:    "go",
+			PushedAfter: "yesterday",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Inverted pushed bounds are rejected", func(t *testing.T) {
+		_, err := searchQueries(&pb.CommitterRequest{
+			Language:     "go",
+			PushedAfter:  "2019-06-30T00:00:00Z",
+			PushedBefore: "2019-01-01T00:00:00Z",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Invalid organization is rejected", func(t *testing.T) {
+		_, err := searchQueries(&pb.CommitterRequest{
+			Language:      "go",
+			Organizations: []string{"not a valid org"},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid organization name")
+	})
+
+	t.Run("Long queries are split", func(t *testing.T) {
+		var orgs []string
+		for i := 0; i < 7; i++ {
+			orgs = append(orgs, strings.Repeat("a", 38)+strconv.Itoa(i))
+		}
+
+		queries, err := searchQueries(&pb.CommitterRequest{
+			Language:      "go",
+			Organizations: orgs,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, queries, 2)
+		for _, q := range queries {
+			assert.True(t, strings.HasPrefix(q, "language:go org:"))
+			assert.True(t, len(q) <= maxSearchQueryLength)
+		}
+	})
+}
+
+func TestCommitterService_MultipleOrganizations(t *testing.T) {
+	testRepoA := "test repo a"
+	testRepoB := "test repo b"
+	testUser := "test user"
+
+	t.Run("Merge contributors across organization searches", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that spans enough organizations to force a query split
+		var orgs []string
+		for i := 0; i < 7; i++ {
+			orgs = append(orgs, strings.Repeat("a", 38)+strconv.Itoa(i))
+		}
+		req := &pb.CommitterRequest{
+			Language:      "testlanguage",
+			Organizations: orgs,
+		}
+
+		queries, err := searchQueries(req)
+		require.Len(t, queries, 2)
+		require.NoError(t, err)
+
+		// And mocked repository getter that returns one repository per search
+		rg := &mockRepoGetter{}
+		for i, repoName := range []string{testRepoA, testRepoB} {
+			name := repoName
+			rg.On("Repositories", ctx, queries[i], mock.Anything).Return(&github.RepositoriesSearchResult{
+				Repositories: []github.Repository{
+					{
+						Owner: &github.User{
+							Login: &testUser,
+						},
+						Name: &name,
+					},
+				},
+			})
+		}
+
+		// And mocked contributor getter that returns one contributor per repository
+		commitsA, commitsB := 2, 1
+		userA, userB := "user a", "user b"
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepoA, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &userA,
+				Contributions: &commitsA,
+			},
+		})
+		cg.On("ListContributors", ctx, testUser, testRepoB, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &userB,
+				Contributions: &commitsB,
+			},
+		})
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And contributors from both searches are merged and ranked
+		assert.Equal(t, []*pb.Committer{
+			{
+				Name:       "user a",
+				Commits:    2,
+				Repository: &pb.Repository{Name: testRepoA},
+			},
+			{
+				Name:       "user b",
+				Commits:    1,
+				Repository: &pb.Repository{Name: testRepoB},
+			},
+		}, resp.Contributors)
+	})
+}
+
+func TestCommitterService_OrgQuery(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("An org only request is served without a language", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And mocked repository getter expecting an org only query
+		commits := 2
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "org:testorg", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner: &github.User{
+						Login: &testUser,
+					},
+					Name: &testRepo,
+				},
+			},
+		})
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &testUser,
+				Contributions: &commits,
+			},
+		})
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called with only the org set
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Org: "testorg"})
+
+		// Then the top committer of the organization is returned
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 1)
+		assert.Equal(t, testUser, resp.Contributors[0].Name)
+		rg.AssertExpectations(t)
+	})
+
+	t.Run("An organizations only request is served without a language", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And mocked repository getter expecting an organizations only query
+		commits := 2
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "org:orga org:orgb", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner: &github.User{
+						Login: &testUser,
+					},
+					Name: &testRepo,
+				},
+			},
+		})
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &testUser,
+				Contributions: &commits,
+			},
+		})
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called with only the organizations set
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Organizations: []string{"orga", "orgb"},
+		})
+
+		// Then the top committer across the organizations is returned
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 1)
+		assert.Equal(t, testUser, resp.Contributors[0].Name)
+		rg.AssertExpectations(t)
+	})
+
+	t.Run("Org combined with the language narrows the search", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And mocked repository getter expecting the combined query
+		commits := 2
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go org:testorg", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner: &github.User{
+						Login: &testUser,
+					},
+					Name: &testRepo,
+				},
+			},
+		})
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &testUser,
+				Contributions: &commits,
+			},
+		})
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called with language and org
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language: "go",
+			Org:      "testorg",
+		})
+
+		// Then the committers of the narrowed search are returned
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 1)
+		rg.AssertExpectations(t)
+	})
+}
+
+func TestCommitterService_ScoreMode(t *testing.T) {
+	testUser := "test user"
+	bigRepo, smallRepo := "big repo", "small repo"
+	whale, helper, fish := "whale", "helper", "fish"
+
+	// scoredService serves a big repository where whale authored 90 of
+	// 100 commits and a small one where fish authored all 9 commits
+	scoredService := func(ctx context.Context) *committerService {
+		whaleCommits, helperCommits, fishCommits := 90, 10, 9
+
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{Owner: &github.User{Login: &testUser}, Name: &bigRepo},
+				{Owner: &github.User{Login: &testUser}, Name: &smallRepo},
+			},
+		})
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, bigRepo, mock.Anything).Return([]*github.Contributor{
+			{Login: &whale, Contributions: &whaleCommits},
+			{Login: &helper, Contributions: &helperCommits},
+		})
+		cg.On("ListContributors", ctx, testUser, smallRepo, mock.Anything).Return([]*github.Contributor{
+			{Login: &fish, Contributions: &fishCommits},
+		})
+
+		return &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+	}
+
+	t.Run("Share mode weights commits by the repository size", func(t *testing.T) {
+		// Given service with a big and a small repository
+		ctx := context.TODO()
+		srv := scoredService(ctx)
+
+		// When most active committer is called in the share score mode
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language:  "go",
+			ScoreMode: pb.ScoreMode_SCORE_MODE_SHARE,
+		})
+
+		// Then the sole committer of the small repository outranks the
+		// raw commit leader of the big one
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 3)
+		assert.Equal(t, fish, resp.Contributors[0].Name)
+		assert.Equal(t, 1.0, resp.Contributors[0].Score)
+		assert.Equal(t, whale, resp.Contributors[1].Name)
+		assert.Equal(t, 0.9, resp.Contributors[1].Score)
+		assert.Equal(t, helper, resp.Contributors[2].Name)
+		assert.Equal(t, 0.1, resp.Contributors[2].Score)
+	})
+
+	t.Run("Raw mode keeps the commit count ranking", func(t *testing.T) {
+		// Given service with a big and a small repository
+		ctx := context.TODO()
+		srv := scoredService(ctx)
+
+		// When most active committer is called without a score mode
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "go"})
+
+		// Then the ranking follows the raw commit counts with no score
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 3)
+		assert.Equal(t, whale, resp.Contributors[0].Name)
+		assert.Equal(t, helper, resp.Contributors[1].Name)
+		assert.Equal(t, fish, resp.Contributors[2].Name)
+		for _, c := range resp.Contributors {
+			assert.Zero(t, c.Score)
+		}
+	})
+
+	t.Run("Unknown score mode is rejected", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When most active committer is called with an unknown mode
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Language:  "go",
+			ScoreMode: pb.ScoreMode(42),
+		})
+
+		// Then the request is rejected
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCommitterService_Pagination(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	// pagedService serves five contributors with descending activity
+	pagedService := func(ctx context.Context) *committerService {
+		names := []string{"first", "second", "third", "fourth", "fifth"}
+		contributors := make([]*github.Contributor, len(names))
+		for i := range names {
+			commits := len(names) - i
+			contributors[i] = &github.Contributor{
+				Login:         &names[i],
+				Contributions: &commits,
+			}
+		}
+
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, mock.Anything).Return(contributors)
+
+		return &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, "testlanguage", testUser, testRepo),
+			contributorsGetter: cg,
+		}
+	}
+
+	contributorNames := func(resp *pb.CommitterResponse) []string {
+		names := make([]string, 0, len(resp.Contributors))
+		for _, c := range resp.Contributors {
+			names = append(names, c.Name)
+		}
+		return names
+	}
+
+	t.Run("First page holds the most active committers", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// When the first page of two committers is requested
+		resp, err := pagedService(ctx).MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language: "testlanguage",
+			PageSize: 2,
+		})
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And the page is filled from the top of the ranking
+		assert.Equal(t, []string{"first", "second"}, contributorNames(resp))
+		assert.Equal(t, uint32(5), resp.TotalCount)
+		assert.Equal(t, uint32(2), resp.NextPage)
+	})
+
+	t.Run("Later pages continue the stable ordering", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// When the second page of two committers is requested
+		resp, err := pagedService(ctx).MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language: "testlanguage",
+			Page:     2,
+			PageSize: 2,
+		})
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And the page picks up where the first one ended
+		assert.Equal(t, []string{"third", "fourth"}, contributorNames(resp))
+		assert.Equal(t, uint32(5), resp.TotalCount)
+		assert.Equal(t, uint32(3), resp.NextPage)
+	})
+
+	t.Run("Last page is shorter and has no next page", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// When the last page is requested
+		resp, err := pagedService(ctx).MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language: "testlanguage",
+			Page:     3,
+			PageSize: 2,
+		})
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And only the remaining committer is returned
+		assert.Equal(t, []string{"fifth"}, contributorNames(resp))
+		assert.Zero(t, resp.NextPage)
+	})
+
+	t.Run("Page behind the collected set is empty", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// When a page far behind the collected set is requested
+		resp, err := pagedService(ctx).MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language: "testlanguage",
+			Page:     10,
+			PageSize: 2,
+		})
+
+		// Then no error is returned and the page is empty
+		require.NoError(t, err)
+		assert.Empty(t, resp.Contributors)
+		assert.Equal(t, uint32(5), resp.TotalCount)
+		assert.Zero(t, resp.NextPage)
+	})
+}
+
+func TestCommitterService_MultipleLanguages(t *testing.T) {
+	testUser := "test user"
+	goRepo := "go repo"
+	rustRepo := "rust repo"
+
+	t.Run("Committers are grouped per requested language", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request that spans two languages
+		req := &pb.CommitterRequest{
+			Languages: []string{"go", "rust"},
+		}
+
+		// And mocked repository getter that returns one repository per language
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner: &github.User{Login: &testUser},
+					Name:  &goRepo,
+				},
+			},
+		})
+		rg.On("Repositories", ctx, "language:rust", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner: &github.User{Login: &testUser},
+					Name:  &rustRepo,
+				},
+			},
+		})
+
+		// And mocked contributor getter that returns one contributor per repository
+		goUser, rustUser := "go user", "rust user"
+		goCommits, rustCommits := 2, 1
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, goRepo, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &goUser,
+				Contributions: &goCommits,
+			},
+		})
+		cg.On("ListContributors", ctx, testUser, rustRepo, mock.Anything).Return([]*github.Contributor{
+			{
+				Login:         &rustUser,
+				Contributions: &rustCommits,
+			},
+		})
+
+		// And service with repoGetter and contributorGetter
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And the single language fields stay empty
+		assert.Empty(t, resp.Language)
+		assert.Empty(t, resp.Contributors)
+
+		// And every language got its own ranking
+		assert.Equal(t, []*pb.LanguageResult{
+			{
+				Language: "go",
+				Contributors: []*pb.Committer{
+					{
+						Name:       "go user",
+						Commits:    2,
+						Repository: &pb.Repository{Name: goRepo},
+					},
+				},
+			},
+			{
+				Language: "rust",
+				Contributors: []*pb.Committer{
+					{
+						Name:       "rust user",
+						Commits:    1,
+						Repository: &pb.Repository{Name: rustRepo},
+					},
+				},
+			},
+		}, resp.Results)
+	})
+
+	t.Run("Failed when no language is provided at all", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When most active committer is called with empty language lists
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Languages: []string{""},
+		})
+
+		// Then an error is returned
+		require.Error(t, err)
+		assert.Equal(t, pb.ErrorCode_INVALID_LANGUAGE, errorCode(t, err))
+	})
+}
+
+func TestCommitterService_Batch(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("Entries are answered in request order", func(t *testing.T) {
+		// Given service that serves go and rust queries
+		ctx := context.TODO()
+		goUser, rustUser := "go user", "rust user"
+		goCommits, rustCommits := 2, 1
+		goRepo, rustRepo := "go repo", "rust repo"
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{Owner: &github.User{Login: &goUser}, Name: &goRepo},
+			},
+		})
+		rg.On("Repositories", ctx, "language:rust", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{Owner: &github.User{Login: &rustUser}, Name: &rustRepo},
+			},
+		})
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, goUser, goRepo, mock.Anything).Return([]*github.Contributor{
+			{Login: &goUser, Contributions: &goCommits},
+		})
+		cg.On("ListContributors", ctx, rustUser, rustRepo, mock.Anything).Return([]*github.Contributor{
+			{Login: &rustUser, Contributions: &rustCommits},
+		})
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When a batch with both languages is served
+		resp, err := srv.BatchMostActiveCommitter(ctx, &pb.BatchCommitterRequest{
+			Requests: []*pb.CommitterRequest{
+				{Language: "go"},
+				{Language: "rust"},
+			},
+		})
+
+		// Then each entry holds the ranking of its request
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		require.NotNil(t, resp.Results[0].Response)
+		assert.Equal(t, "go user", resp.Results[0].Response.Contributors[0].Name)
+		require.NotNil(t, resp.Results[1].Response)
+		assert.Equal(t, "rust user", resp.Results[1].Response.Contributors[0].Name)
+	})
+
+	t.Run("A failed entry does not fail the batch", func(t *testing.T) {
+		// Given service whose rust search fails
+		ctx := context.TODO()
+		commits := 2
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{Owner: &github.User{Login: &testUser}, Name: &testRepo},
+			},
+		})
+		rg.On("Repositories", ctx, "language:rust", mock.Anything).Return(errors.New("test error"))
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, mock.Anything).Return([]*github.Contributor{
+			{Login: &testUser, Contributions: &commits},
+		})
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When a batch with both languages is served
+		resp, err := srv.BatchMostActiveCommitter(ctx, &pb.BatchCommitterRequest{
+			Requests: []*pb.CommitterRequest{
+				{Language: "go"},
+				{Language: "rust"},
+			},
+		})
+
+		// Then the failure is reported in its entry only
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		require.NotNil(t, resp.Results[0].Response)
+		assert.Nil(t, resp.Results[1].Response)
+		assert.EqualValues(t, codes.Internal, resp.Results[1].Code)
+		assert.Equal(t, "Failed at finding projects", resp.Results[1].Error)
+	})
+
+	t.Run("An empty batch is rejected", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When a batch without requests is served
+		_, err := srv.BatchMostActiveCommitter(context.TODO(), &pb.BatchCommitterRequest{})
+
+		// Then the batch is rejected as a whole
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+type rateLimitedRepoGetter struct {
+	reset time.Time
+}
+
+// nolint
+func (g rateLimitedRepoGetter) Repositories(ctx context.Context, query string,
+	opt *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+
+	return nil, &github.Response{
+		Rate: github.Rate{
+			Limit:     5000,
+			Remaining: 0,
+			Reset:     github.Timestamp{Time: g.reset},
+		},
+	}, errors.New("403 API rate limit exceeded")
+}
+
+// errorCode extracts the stable error category from a status error
+func errorCode(t *testing.T, err error) pb.ErrorCode {
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	detail, ok := details[0].(*pb.ErrorDetail)
+	require.True(t, ok, "status detail is not an ErrorDetail")
+	return detail.Code
+}
+
+func TestCommitterService_ErrorCodes(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("Missing language carries INVALID_LANGUAGE", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When most active committer is called without a language
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{})
+
+		// Then the status detail carries the category
+		require.Error(t, err)
+		assert.Equal(t, pb.ErrorCode_INVALID_LANGUAGE, errorCode(t, err))
+	})
+
+	t.Run("Failed repository search carries SEARCH_FAILED", func(t *testing.T) {
+		// Given service whose repository search fails
+		ctx := context.TODO()
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, mock.Anything, mock.Anything).Return(errors.New("test error"))
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rg,
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "testlanguage"})
+
+		// Then the status detail carries the category
+		require.Error(t, err)
+		assert.Equal(t, pb.ErrorCode_SEARCH_FAILED, errorCode(t, err))
+	})
+
+	t.Run("Failed contributor lookup carries CONTRIBUTORS_FAILED", func(t *testing.T) {
+		// Given service whose contributor lookup fails
+		ctx := context.TODO()
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, testRepo, mock.Anything).Return(errors.New("test error"))
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, "testlanguage", testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "testlanguage"})
+
+		// Then the status detail carries the category
+		require.Error(t, err)
+		assert.Equal(t, pb.ErrorCode_CONTRIBUTORS_FAILED, errorCode(t, err))
+	})
+
+	t.Run("Failed search carries the language and the upstream class", func(t *testing.T) {
+		// Given service whose repository search fails with a GitHub
+		// server error
+		ctx := context.TODO()
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, mock.Anything, mock.Anything).Return(&github.ErrorResponse{
+			Response: &http.Response{StatusCode: http.StatusBadGateway},
+		})
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rg,
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "testlanguage"})
+
+		// Then the status detail carries the cause while the message
+		// stays generic
+		require.Error(t, err)
+		details := status.Convert(err).Details()
+		require.Len(t, details, 1)
+		detail, ok := details[0].(*pb.ErrorDetail)
+		require.True(t, ok, "status detail is not an ErrorDetail")
+		assert.Equal(t, pb.ErrorCode_SEARCH_FAILED, detail.Code)
+		assert.Equal(t, "testlanguage", detail.Language)
+		assert.Equal(t, "github_server", detail.UpstreamClass)
+		assert.Equal(t, "Failed at finding projects", status.Convert(err).Message())
+	})
+
+	t.Run("Exhausted quota carries RATE_LIMITED", func(t *testing.T) {
+		// Given service backed by GitHub with no remaining quota
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rateLimitedRepoGetter{reset: time.Now()},
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{Language: "testlanguage"})
+
+		// Then the status detail carries the category
+		require.Error(t, err)
+		assert.Equal(t, pb.ErrorCode_RATE_LIMITED, errorCode(t, err))
+	})
+}
+
+func TestCommitterService_RateLimitExhausted(t *testing.T) {
+	t.Run("Exhausted quota maps to ResourceExhausted with the reset time", func(t *testing.T) {
+		// Given service backed by GitHub with no remaining quota
+		reset := time.Date(2019, 3, 12, 20, 0, 0, 0, time.UTC)
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rateLimitedRepoGetter{reset: reset},
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Language: "testlanguage",
+		})
+
+		// Then the rate limit error carries the reset time
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Contains(t, err.Error(), reset.Format(time.RFC3339))
+	})
+}
+
+type hangingRepoGetter struct{}
+
+func (hangingRepoGetter) Repositories(ctx context.Context, query string,
+	opt *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+
+	<-ctx.Done()
+	return nil, nil, ctx.Err()
+}
+
+func TestCommitterService_UpstreamTimeout(t *testing.T) {
+	t.Run("Hanging GitHub call is cut off with DeadlineExceeded", func(t *testing.T) {
+		// Given service with a short upstream timeout and a hanging GitHub API
+		srv := &committerService{
+			logger:          zap.L(),
+			repoGetter:      hangingRepoGetter{},
+			upstreamTimeout: 10 * time.Millisecond,
+		}
+
+		// When most active committer is called
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Language: "testlanguage",
+		})
+
+		// Then the deadline error code is returned
+		require.Error(t, err)
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+}
+
+type endlessPagesContGetter struct {
+	calls int
+}
+
+func (m *endlessPagesContGetter) ListContributors(ctx context.Context, owner string, repoName string,
+	opt *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error) {
+
+	m.calls++
+	login := fmt.Sprintf("user-%d", opt.Page)
+	contributions := 1
+	return []*github.Contributor{
+			{
+				Login:         &login,
+				Contributions: &contributions,
+			},
+		},
+		&github.Response{NextPage: opt.Page + 1}, nil
+}
+
+func TestCommitterService_MaxContributorPages(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("Stop fetching contributors when the page limit is reached", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And contributor getter that always reports a next page
+		cg := &endlessPagesContGetter{}
+
+		// And service with bounded contributor pagination
+		srv := &committerService{
+			logger:              zap.L(),
+			repoGetter:          repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter:  cg,
+			maxContributorPages: 3,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And only the configured number of pages was fetched
+		assert.Equal(t, 3, cg.calls)
+		assert.Len(t, resp.Contributors, 3)
+	})
+}
+
+type overlappingPagesContGetter struct {
+	calls int
+}
+
+// ListContributors serves two pages that both contain "repeated", the
+// way shifting page boundaries can resend a contributor
+func (m *overlappingPagesContGetter) ListContributors(ctx context.Context, owner string, repoName string,
+	opt *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error) {
+
+	m.calls++
+	repeated, other := "repeated", fmt.Sprintf("user-%d", opt.Page)
+	repeatedCommits, otherCommits := 5, 1
+	contributors := []*github.Contributor{
+		{Login: &repeated, Contributions: &repeatedCommits},
+		{Login: &other, Contributions: &otherCommits},
+	}
+	if opt.Page > 0 {
+		return contributors, nil, nil
+	}
+	return contributors, &github.Response{NextPage: 1}, nil
+}
+
+func TestCommitterService_ContributorDeduplication(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("A contributor resent on a later page is counted once", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And test request
+		req := &pb.CommitterRequest{
+			Language: "testlanguage",
+		}
+
+		// And contributor getter whose pages overlap
+		cg := &overlappingPagesContGetter{}
+
+		// And service paging through the contributors
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         repositoryGetterSetup(ctx, req.Language, testUser, testRepo),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, req)
+
+		// Then no error is returned
+		require.NoError(t, err)
+		require.Equal(t, 2, cg.calls)
+
+		// And the repeated contributor appears exactly once
+		names := make([]string, 0, len(resp.Contributors))
+		for _, c := range resp.Contributors {
+			names = append(names, c.Name)
+		}
+		assert.Equal(t, []string{"repeated", "user-0", "user-1"}, names)
+	})
+}
+
+func TestCommitterService_ShortCircuit(t *testing.T) {
+	testUser := "test user"
+	firstRepo, secondRepo := "first repo", "second repo"
+
+	// twoRepoGetter serves two repositories for every search
+	twoRepoGetter := func(ctx context.Context) *mockRepoGetter {
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:testlanguage", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{Owner: &github.User{Login: &testUser}, Name: &firstRepo},
+				{Owner: &github.User{Login: &testUser}, Name: &secondRepo},
+			},
+		})
+		return rg
+	}
+
+	firstRepoContributors := func(ctx context.Context) *mockContGetter {
+		commitsA, commitsB := 2, 1
+		userA, userB := "user a", "user b"
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, firstRepo, mock.Anything).Return([]*github.Contributor{
+			{Login: &userA, Contributions: &commitsA},
+			{Login: &userB, Contributions: &commitsB},
+		})
+		return cg
+	}
+
+	t.Run("Later repositories are skipped once the target is met", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And contributor getter that only expects the first repository
+		cg := firstRepoContributors(ctx)
+
+		// And service serving two repositories
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         twoRepoGetter(ctx),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called with the short circuit
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language:        "testlanguage",
+			MaxContributors: 2,
+			ShortCircuit:    true,
+		})
+
+		// Then the first repository satisfied the request and the
+		// second one was never queried
+		require.NoError(t, err)
+		assert.Len(t, resp.Contributors, 2)
+		cg.AssertNotCalled(t, "ListContributors", ctx, testUser, secondRepo, mock.Anything)
+	})
+
+	t.Run("Without the short circuit every repository is scanned", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And contributor getter expecting both repositories
+		commits := 1
+		userC := "user c"
+		cg := firstRepoContributors(ctx)
+		cg.On("ListContributors", ctx, testUser, secondRepo, mock.Anything).Return([]*github.Contributor{
+			{Login: &userC, Contributions: &commits},
+		})
+
+		// And service serving two repositories
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         twoRepoGetter(ctx),
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called without the short circuit
+		_, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language:        "testlanguage",
+			MaxContributors: 2,
+		})
+
+		// Then both repositories were queried
+		require.NoError(t, err)
+		cg.AssertExpectations(t)
+	})
+}
+
+func TestCommitterService_RepoSort(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	repoGetterExpectingSort := func(ctx context.Context, sort string) *mockRepoGetter {
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:testlanguage", &github.SearchOptions{
+			Sort:  sort,
+			Order: "desc",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: 5,
+			},
+		}).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner: &github.User{
+						Login: &testUser,
+					},
+					Name: &testRepo,
+				},
+			},
+		})
+		return rg
+	}
+
+	tests := []struct {
+		name     string
+		repoSort pb.RepoSort
+		sortKey  string
+	}{
+		{"Default sorts by stars", pb.RepoSort_REPO_SORT_STARS, "stars"},
+		{"Forks", pb.RepoSort_REPO_SORT_FORKS, "forks"},
+		{"Recent activity", pb.RepoSort_REPO_SORT_UPDATED, "updated"},
+		{"Help wanted issues", pb.RepoSort_REPO_SORT_HELP_WANTED_ISSUES, "help-wanted-issues"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given empty context
+			ctx := context.TODO()
+
+			// And test request with the sort preference
+			req := &pb.CommitterRequest{
+				Language: "testlanguage",
+				RepoSort: tc.repoSort,
+			}
+
+			// And mocked repository getter expecting the mapped sort key
+			rg := repoGetterExpectingSort(ctx, tc.sortKey)
+
+			// And service
+			srv := &committerService{
+				logger:             zap.L(),
+				repoGetter:         rg,
+				contributorsGetter: contributorGetterSetup(ctx, testUser, testRepo, 1),
+			}
+
+			// When most active committer is called
+			_, err := srv.MostActiveCommitter(ctx, req)
+
+			// Then no error is returned and the mock expectation was met
+			require.NoError(t, err)
+			rg.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Unknown sort is rejected", func(t *testing.T) {
+		// Given empty service
+		srv := &committerService{}
+
+		// When most active committer is called with an unmapped sort value
+		_, err := srv.MostActiveCommitter(context.TODO(), &pb.CommitterRequest{
+			Language: "testlanguage",
+			RepoSort: pb.RepoSort(42),
+		})
+
+		// Then an error is returned
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "sort")
+	})
+}
+
+func TestCommitterService_RepositoryMetadata(t *testing.T) {
+	testUser := "test user"
+	testRepo := "most-popular-committer"
+	fullName := "RafalKorepta/most-popular-committer"
+	htmlURL := "https://github.com/RafalKorepta/most-popular-committer"
+	goLang := "Go"
+	stars := 42
+
+	t.Run("The source repository details reach the committer", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And mocked repository getter serving a fully described repository
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{
+					Owner:           &github.User{Login: &testUser},
+					Name:            &testRepo,
+					FullName:        &fullName,
+					Language:        &goLang,
+					StargazersCount: &stars,
+					HTMLURL:         &htmlURL,
+				},
+			},
+		})
+
+		// And service
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: contributorGetterSetup(ctx, testUser, testRepo, 1),
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "go"})
+
+		// Then the committer links back to its repository
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 1)
+		repoMeta := resp.Contributors[0].Repository
+		require.NotNil(t, repoMeta)
+		assert.Equal(t, fullName, repoMeta.FullName)
+		assert.EqualValues(t, 42, repoMeta.Stars)
+		assert.Equal(t, htmlURL, repoMeta.HtmlUrl)
+	})
+}
+
+func TestCommitterService_PartialRepositories(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+	contributions := 3
+
+	t.Run("Repositories without an owner or a name are skipped", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And mocked repository getter returning partially populated
+		// repositories next to a complete one
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{Name: &testRepo},
+				{Owner: &github.User{Login: &testUser}},
+				{Owner: &github.User{}, Name: &testRepo},
+				{Owner: &github.User{Login: &testUser}, Name: &testRepo},
+			},
+		})
+
+		// And service
+		cg := contributorGetterSetup(ctx, testUser, testRepo, contributions)
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		var resp *pb.CommitterResponse
+		var err error
+		require.NotPanics(t, func() {
+			resp, err = srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "go"})
+		})
+
+		// Then only the complete repository contributes to the ranking
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 1)
+		assert.Equal(t, testUser, resp.Contributors[0].Name)
+	})
+}
+
+func TestCommitterService_SearchTotals(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+	total := 12345
+	incomplete := true
+
+	t.Run("The search total and truncation reach the response", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And mocked repository getter reporting a large incomplete search
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Total:             &total,
+			IncompleteResults: &incomplete,
+			Repositories: []github.Repository{
+				{
+					Owner: &github.User{Login: &testUser},
+					Name:  &testRepo,
+				},
+			},
+		})
+
+		// And service
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: contributorGetterSetup(ctx, testUser, testRepo, 1),
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "go"})
+
+		// Then the response tells how big the search actually was
+		require.NoError(t, err)
+		assert.EqualValues(t, 12345, resp.TotalRepositories)
+		assert.True(t, resp.IncompleteResults)
+	})
+}
+
+func TestCommitterService_Cancellation(t *testing.T) {
+	testUser := "test user"
+	firstRepo := "first repo"
+	secondRepo := "second repo"
+	contributions := 1
+
+	t.Run("Cancelled request stops before the next repository", func(t *testing.T) {
+		// Given a context that gets cancelled while the first
+		// repository is processed
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// And mocked repository getter returning two repositories
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "language:go", mock.Anything).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{Owner: &github.User{Login: &testUser}, Name: &firstRepo},
+				{Owner: &github.User{Login: &testUser}, Name: &secondRepo},
+			},
+		})
+
+		// And mocked contributors getter that cancels the request
+		// during the first repository
+		cg := &mockContGetter{}
+		cg.On("ListContributors", ctx, testUser, firstRepo, mock.Anything).
+			Run(func(mock.Arguments) { cancel() }).
+			Return([]*github.Contributor{
+				{Login: &testUser, Contributions: &contributions},
+			})
+
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+
+		// When most active committer is called
+		resp, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "go"})
+
+		// Then the call fails as cancelled and the second repository
+		// is never fetched
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		cg.AssertNumberOfCalls(t, "ListContributors", 1)
+	})
+}
+
+func TestCommitterService_ListPopularLanguages(t *testing.T) {
+	goLang := "Go"
+	rustLang := "Rust"
+	goRepo := "go repo"
+	secondGoRepo := "second go repo"
+	rustRepo := "rust repo"
+	unclassifiedRepo := "unclassified repo"
+	goStars := 70
+	secondGoStars := 30
+	rustStars := 120
+
+	repoGetterWithStars := func(ctx context.Context, perPage int) *mockRepoGetter {
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "stars:>1", &github.SearchOptions{
+			Sort:  "stars",
+			Order: "desc",
+			ListOptions: github.ListOptions{
+				Page:    0,
+				PerPage: perPage,
+			},
+		}).Return(&github.RepositoriesSearchResult{
+			Repositories: []github.Repository{
+				{Name: &rustRepo, Language: &rustLang, StargazersCount: &rustStars},
+				{Name: &goRepo, Language: &goLang, StargazersCount: &goStars},
+				{Name: &secondGoRepo, Language: &goLang, StargazersCount: &secondGoStars},
+				{Name: &unclassifiedRepo},
+			},
+		})
+		return rg
+	}
+
+	t.Run("Languages are ranked by their aggregate stars", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And service with mocked repository getter
+		rg := repoGetterWithStars(ctx, 5)
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rg,
+		}
+
+		// When the popular languages are listed
+		resp, err := srv.ListPopularLanguages(ctx, &pb.LanguagesRequest{})
+
+		// Then the aggregated languages come back ranked by stars
+		require.NoError(t, err)
+		require.Len(t, resp.Languages, 2)
+		assert.Equal(t, "Rust", resp.Languages[0].Name)
+		assert.EqualValues(t, 120, resp.Languages[0].Stars)
+		assert.EqualValues(t, 1, resp.Languages[0].Repositories)
+		assert.Equal(t, "Go", resp.Languages[1].Name)
+		assert.EqualValues(t, 100, resp.Languages[1].Stars)
+		assert.EqualValues(t, 2, resp.Languages[1].Repositories)
+		rg.AssertExpectations(t)
+	})
+
+	t.Run("The result is bounded by max_languages", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And service with mocked repository getter
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: repoGetterWithStars(ctx, 5),
+		}
+
+		// When a single language is asked for
+		resp, err := srv.ListPopularLanguages(ctx, &pb.LanguagesRequest{MaxLanguages: 1})
+
+		// Then only the top ranked language comes back
+		require.NoError(t, err)
+		require.Len(t, resp.Languages, 1)
+		assert.Equal(t, "Rust", resp.Languages[0].Name)
+	})
+
+	t.Run("The requested repository budget reaches the search", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And service with mocked repository getter expecting the budget
+		rg := repoGetterWithStars(ctx, 42)
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rg,
+		}
+
+		// When the popular languages are listed with an explicit budget
+		_, err := srv.ListPopularLanguages(ctx, &pb.LanguagesRequest{TopRepositories: 42})
+
+		// Then no error is returned and the mock expectation was met
+		require.NoError(t, err)
+		rg.AssertExpectations(t)
+	})
+
+	t.Run("Failed when repositories query filed", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And mocked repository getter that fails
+		rg := &mockRepoGetter{}
+		rg.On("Repositories", ctx, "stars:>1", mock.Anything).Return(errors.New("test error"))
+
+		// And service
+		srv := &committerService{
+			logger:     zap.L(),
+			repoGetter: rg,
+		}
+
+		// When the popular languages are listed
+		_, err := srv.ListPopularLanguages(ctx, &pb.LanguagesRequest{})
+
+		// Then an error is returned
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Failed at finding projects")
+	})
+}
+
+func repositoryGetterSetup(ctx context.Context, language, user, repo string) RepositoryGetter {
+	rg := &mockRepoGetter{}
+
+	rg.On("Repositories", ctx, "language:"+language, &github.SearchOptions{
+		Sort:  "stars",
+		Order: "desc",
+		ListOptions: github.ListOptions{
+			Page:    0,
+			PerPage: 5,
+		},
+	}).Return(&github.RepositoriesSearchResult{
+		Repositories: []github.Repository{
+			{
+				Owner: &github.User{
+					Login: &user,
+				},
+				Name: &repo,
+			},
+		},
+	})
+
+	return rg
+}
+
+func contributorGetterSetup(ctx context.Context, user, repo string, contributions int) ContributorsGetter {
+	// And mocked contributor getter
+	cg := &mockContGetter{}
+	cg.On("ListContributors", ctx, user, repo, &github.ListContributorsOptions{
+		Anon: "false",
+		ListOptions: github.ListOptions{
+			Page:    0,
+			PerPage: 10,
+		},
+	}).Return([]*github.Contributor{
+		{
+			Login:         &user,
+			Contributions: &contributions,
+		},
+	})
+	return cg
+}
@@ -0,0 +1,102 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+)
+
+// Config mirrors the serve command flags so the server can be embedded
+// in another Go program without replicating the flag-to-option wiring
+// or depending on cobra and viper. Zero values fall back to the same
+// defaults the serve command uses.
+type Config struct {
+	// BindAddress is the IP the server listens on. Defaults to
+	// 127.0.0.1
+	BindAddress string
+	// Port is the TCP port the server listens on. Defaults to 9091
+	Port int
+
+	// Secure serves TLS connections using CertFile and KeyFile
+	Secure   bool
+	CertFile string
+	KeyFile  string
+
+	// Rate and Capacity configure the request rate limiter. They
+	// default to 25 requests per second with a capacity of 10
+	Rate     int64
+	Capacity int64
+
+	// GitHubToken is the personal access token used for GitHub API
+	// calls
+	GitHubToken string
+}
+
+// NewServerFromConfig builds the network listener described by the
+// configuration and constructs the server with the matching options.
+// Additional options can be passed for everything the Config does not
+// cover and take precedence over it.
+func NewServerFromConfig(cfg Config, opts ...Option) (*Server, error) {
+	bindAddress := cfg.BindAddress
+	if bindAddress == "" {
+		bindAddress = "127.0.0.1"
+	}
+	bindIP := net.ParseIP(bindAddress)
+	if bindIP == nil {
+		return nil, fmt.Errorf("malformed bind address %q", bindAddress)
+	}
+	port := cfg.Port
+	if port == 0 {
+		port = 9091
+	}
+	if port < 1 || port > 65535 {
+		return nil, fmt.Errorf("port number %d is outside the valid range 1-65535", port)
+	}
+
+	listener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: bindIP, Port: port})
+	if err != nil {
+		return nil, err
+	}
+
+	srv, err := NewServer(listener, append(cfg.options(), opts...)...)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+	return srv, nil
+}
+
+// options translates the configuration into server options, applying
+// the serve command defaults to unset values
+func (c Config) options() []Option {
+	rate := c.Rate
+	if rate == 0 {
+		rate = 25
+	}
+	capacity := c.Capacity
+	if capacity == 0 {
+		capacity = 10
+	}
+
+	return []Option{
+		WithSecure(c.Secure),
+		WithCertFile(c.CertFile),
+		WithKeyFile(c.KeyFile),
+		WithRate(rate),
+		WithCapacity(capacity),
+		WithGitHubToken(c.GitHubToken),
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServerFromConfig(t *testing.T) {
+	t.Run("Listener and options are built from the config", func(t *testing.T) {
+		// Given a free TCP port
+		probe, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		port := probe.Addr().(*net.TCPAddr).Port
+		require.NoError(t, probe.Close())
+
+		// When the server is built from a config
+		srv, err := NewServerFromConfig(Config{
+			BindAddress: "127.0.0.1",
+			Port:        port,
+			Rate:        5,
+			Capacity:    7,
+			GitHubToken: "test token",
+		})
+
+		// Then the listener and options match the config
+		require.NoError(t, err)
+		defer func() { _ = srv.listener.Close() }()
+		assert.Contains(t, srv.listener.Addr().String(), strconv.Itoa(port))
+		assert.EqualValues(t, 5, srv.rate)
+		assert.EqualValues(t, 7, srv.capacity)
+		assert.Equal(t, "test token", srv.githubToken)
+	})
+
+	t.Run("Unset rate and capacity fall back to the serve defaults", func(t *testing.T) {
+		// Given an empty config applied to a server
+		srv, err := NewServer(&mockListener{}, Config{}.options()...)
+
+		// Then the serve command defaults are used
+		require.NoError(t, err)
+		assert.EqualValues(t, 25, srv.rate)
+		assert.EqualValues(t, 10, srv.capacity)
+	})
+
+	t.Run("Malformed bind address is rejected", func(t *testing.T) {
+		// When the server is built with a malformed address
+		_, err := NewServerFromConfig(Config{BindAddress: "not an address"})
+
+		// Then the configuration is rejected before anything listens
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "malformed bind address")
+	})
+
+	t.Run("Out of range port is rejected", func(t *testing.T) {
+		// When the server is built with an out of range port
+		_, err := NewServerFromConfig(Config{Port: 65536})
+
+		// Then the configuration is rejected before anything listens
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "outside the valid range")
+	})
+}
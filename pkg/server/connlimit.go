@@ -0,0 +1,116 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// perIPListener bounds how many connections a single client IP may hold
+// open at the same time. Excess connections are closed right after they
+// were accepted, which protects the server from connection exhaustion
+// by a single client.
+type perIPListener struct {
+	net.Listener
+
+	maxConns int
+
+	mu     sync.Mutex
+	active map[string]int
+}
+
+// limitConnsPerIP wraps the listener so no client IP can hold more than
+// maxConns simultaneous connections
+func limitConnsPerIP(l net.Listener, maxConns int) net.Listener {
+	return &perIPListener{
+		Listener: l,
+		maxConns: maxConns,
+		active:   map[string]int{},
+	}
+}
+
+func (l *perIPListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		ip := remoteIP(conn)
+		if !l.acquire(ip) {
+			zap.L().Warn("Rejecting connection above the per IP limit",
+				zap.String("ip", ip),
+				zap.Int("maxConnsPerIP", l.maxConns))
+			_ = conn.Close()
+			continue
+		}
+
+		return &countedConn{
+			Conn:    conn,
+			release: func() { l.release(ip) },
+		}, nil
+	}
+}
+
+// acquire reserves a connection slot for the given IP. It reports false
+// when the IP already holds the maximum number of connections.
+func (l *perIPListener) acquire(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.active[ip] >= l.maxConns {
+		return false
+	}
+	l.active[ip]++
+	return true
+}
+
+func (l *perIPListener) release(ip string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.active[ip] <= 1 {
+		delete(l.active, ip)
+		return
+	}
+	l.active[ip]--
+}
+
+// remoteIP extracts the client IP of a connection. Addresses without a
+// host and port, such as unix sockets, share a single budget.
+func remoteIP(conn net.Conn) string {
+	host, _, err := net.SplitHostPort(conn.RemoteAddr().String())
+	if err != nil {
+		return conn.RemoteAddr().String()
+	}
+	return host
+}
+
+// countedConn gives the reserved connection slot back when the
+// connection is closed
+type countedConn struct {
+	net.Conn
+
+	once    sync.Once
+	release func()
+}
+
+func (c *countedConn) Close() error {
+	defer c.once.Do(c.release)
+	return c.Conn.Close()
+}
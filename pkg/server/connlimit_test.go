@@ -0,0 +1,89 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerIPConnectionLimit(t *testing.T) {
+	t.Run("Excess connections from one IP are refused", func(t *testing.T) {
+		// Given a TCP listener bounded to 2 connections per IP
+		inner, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		limited := limitConnsPerIP(inner, 2)
+		defer limited.Close()
+
+		// And an echo server accepting on it
+		go func() {
+			for {
+				conn, err := limited.Accept()
+				if err != nil {
+					return
+				}
+				go func(c net.Conn) {
+					defer c.Close()
+					_, _ = io.Copy(c, c)
+				}(conn)
+			}
+		}()
+
+		dial := func() net.Conn {
+			conn, err := net.Dial("tcp", inner.Addr().String())
+			require.NoError(t, err)
+			require.NoError(t, conn.SetDeadline(time.Now().Add(time.Second)))
+			return conn
+		}
+		echo := func(conn net.Conn) error {
+			if _, err := conn.Write([]byte("ping")); err != nil {
+				return err
+			}
+			_, err := io.ReadFull(conn, make([]byte, 4))
+			return err
+		}
+
+		// When more connections than the limit are opened
+		first, second, third := dial(), dial(), dial()
+		defer second.Close()
+		defer third.Close()
+
+		// Then the connections within the limit are served
+		assert.NoError(t, echo(first))
+		assert.NoError(t, echo(second))
+
+		// And the excess connection is closed by the server
+		assert.Error(t, echo(third))
+
+		// And closing a connection frees its slot for a new one
+		require.NoError(t, first.Close())
+		require.Eventually(t, func() bool {
+			conn, err := net.Dial("tcp", inner.Addr().String())
+			if err != nil {
+				return false
+			}
+			defer conn.Close()
+			if err := conn.SetDeadline(time.Now().Add(time.Second)); err != nil {
+				return false
+			}
+			return echo(conn) == nil
+		}, time.Second, 10*time.Millisecond)
+	})
+}
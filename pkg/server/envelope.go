@@ -0,0 +1,124 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// envelopeQueryParam toggles the response envelope per request
+	envelopeQueryParam = "envelope"
+
+	// envelopeAccept is the media type that asks for enveloped output
+	// through content negotiation
+	envelopeAccept = "application/vnd.committer.envelope+json"
+)
+
+// envelopeMeta carries response metadata separated from the payload
+type envelopeMeta struct {
+	Status     int    `json:"status"`
+	DurationMs int64  `json:"duration_ms"`
+	Server     string `json:"server"`
+}
+
+// envelope is the optional response shape {"meta":{...},"data":{...}}
+type envelope struct {
+	Meta envelopeMeta    `json:"meta"`
+	Data json.RawMessage `json:"data"`
+}
+
+// envelopeRecorder buffers the downstream response so it can be wrapped
+type envelopeRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newEnvelopeRecorder() *envelopeRecorder {
+	return &envelopeRecorder{
+		header: http.Header{},
+		status: http.StatusOK,
+	}
+}
+
+func (r *envelopeRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *envelopeRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *envelopeRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+// envelopeRequested reports whether the client asked for the envelope
+// either by the envelope query parameter or the Accept header
+func envelopeRequested(req *http.Request) bool {
+	if req.URL.Query().Get(envelopeQueryParam) == "true" {
+		return true
+	}
+	return strings.Contains(req.Header.Get("Accept"), envelopeAccept)
+}
+
+// envelopeHandler optionally wraps JSON responses of the next handler in
+// a {"meta":{...},"data":{...}} envelope. Responses are passed through
+// untouched unless the request asks for the envelope.
+func envelopeHandler(serverName string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !envelopeRequested(req) {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		start := time.Now()
+		rec := newEnvelopeRecorder()
+		next.ServeHTTP(rec, req)
+
+		wrapped, err := json.Marshal(envelope{
+			Meta: envelopeMeta{
+				Status:     rec.status,
+				DurationMs: time.Since(start).Nanoseconds() / int64(time.Millisecond),
+				Server:     serverName,
+			},
+			Data: rec.body.Bytes(),
+		})
+		if err != nil {
+			zap.L().Error("Enveloping the response failed", zap.Error(err))
+			http.Error(w, "unable to envelope the response", http.StatusInternalServerError)
+			return
+		}
+
+		for k, v := range rec.header {
+			if k == "Content-Length" {
+				continue
+			}
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(rec.status)
+		if _, err := w.Write(wrapped); err != nil {
+			zap.L().Error("Writing the enveloped response failed", zap.Error(err))
+		}
+	})
+}
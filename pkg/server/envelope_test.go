@@ -0,0 +1,95 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopeHandler(t *testing.T) {
+	payload := `{"language":"go","contributors":[]}`
+	handler := envelopeHandler("backend", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(payload))
+	}))
+
+	t.Run("Response is untouched without the envelope request", func(t *testing.T) {
+		// Given plain request
+		req := httptest.NewRequest(http.MethodGet, "/v1alpha1/committer?language=go", nil)
+		rec := httptest.NewRecorder()
+
+		// When the request is handled
+		handler.ServeHTTP(rec, req)
+
+		// Then the original payload shape is returned
+		assert.JSONEq(t, payload, rec.Body.String())
+	})
+
+	t.Run("Envelope query parameter wraps the response", func(t *testing.T) {
+		// Given request with envelope=true
+		req := httptest.NewRequest(http.MethodGet, "/v1alpha1/committer?language=go&envelope=true", nil)
+		rec := httptest.NewRecorder()
+
+		// When the request is handled
+		handler.ServeHTTP(rec, req)
+
+		// Then the payload is wrapped in meta and data
+		var resp envelope
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, http.StatusOK, resp.Meta.Status)
+		assert.Equal(t, "backend", resp.Meta.Server)
+		assert.JSONEq(t, payload, string(resp.Data))
+	})
+
+	t.Run("Envelope Accept header wraps the response", func(t *testing.T) {
+		// Given request with the envelope media type
+		req := httptest.NewRequest(http.MethodGet, "/v1alpha1/committer?language=go", nil)
+		req.Header.Set("Accept", envelopeAccept)
+		rec := httptest.NewRecorder()
+
+		// When the request is handled
+		handler.ServeHTTP(rec, req)
+
+		// Then the payload is wrapped in meta and data
+		var resp envelope
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.JSONEq(t, payload, string(resp.Data))
+	})
+
+	t.Run("Downstream status code is preserved", func(t *testing.T) {
+		// Given a failing downstream handler
+		failing := envelopeHandler("backend", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/missing?envelope=true", nil)
+		rec := httptest.NewRecorder()
+
+		// When the request is handled
+		failing.ServeHTTP(rec, req)
+
+		// Then the status code and the meta agree
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		var resp envelope
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, http.StatusNotFound, resp.Meta.Status)
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// etagEntry remembers the validator and body of one cached GET response
+type etagEntry struct {
+	etag     string
+	body     []byte
+	header   http.Header
+	lastUsed time.Time
+}
+
+// etagCache replays GitHub conditional requests. GitHub answers a GET
+// carrying a known If-None-Match with 304 Not Modified, which does not
+// count against the rate limit, so cached responses are served for free
+// as long as the upstream data did not change.
+type etagCache struct {
+	next http.RoundTripper
+	now  func() time.Time
+
+	mu         sync.Mutex
+	entries    map[string]*etagEntry
+	maxEntries int
+}
+
+// newETagCache wraps next so GET responses carrying an ETag are stored
+// and revalidated with conditional requests. At most maxEntries
+// responses are kept, the least recently used one is evicted first
+func newETagCache(next http.RoundTripper, maxEntries int) *etagCache {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &etagCache{
+		next:       next,
+		now:        time.Now,
+		entries:    make(map[string]*etagEntry, maxEntries),
+		maxEntries: maxEntries,
+	}
+}
+
+func (c *etagCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return c.next.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	cached := c.lookup(key)
+
+	// The request is cloned because a RoundTripper must not modify
+	// the original one
+	conditional := req.Clone(req.Context())
+	if cached != nil {
+		conditional.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := c.next.RoundTrip(conditional)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		return c.replay(resp, cached)
+	}
+
+	if resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "" {
+		return c.store(key, resp)
+	}
+	return resp, nil
+}
+
+// lookup returns the cached entry for the key and marks it as recently
+// used, or nil when nothing is cached
+func (c *etagCache) lookup(key string) *etagEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := c.entries[key]
+	if entry != nil {
+		entry.lastUsed = c.now()
+	}
+	return entry
+}
+
+// replay turns a 304 Not Modified into the full response stored earlier.
+// The rate limit headers of the 304 are kept because they reflect the
+// current budget, unlike the ones recorded with the body
+func (c *etagCache) replay(resp *http.Response, cached *etagEntry) (*http.Response, error) {
+	_, _ = ioutil.ReadAll(resp.Body)
+	if err := resp.Body.Close(); err != nil {
+		return nil, err
+	}
+
+	replayed := new(http.Response)
+	*replayed = *resp
+	replayed.StatusCode = http.StatusOK
+	replayed.Status = http.StatusText(http.StatusOK)
+	replayed.Body = ioutil.NopCloser(bytes.NewReader(cached.body))
+	replayed.ContentLength = int64(len(cached.body))
+
+	replayed.Header = make(http.Header, len(cached.header))
+	for name, values := range cached.header {
+		replayed.Header[name] = values
+	}
+	for _, name := range []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset"} {
+		if value := resp.Header.Get(name); value != "" {
+			replayed.Header.Set(name, value)
+		}
+	}
+	return replayed, nil
+}
+
+// store reads the response body into the cache and hands the caller an
+// equivalent response backed by the stored bytes
+func (c *etagCache) store(key string, resp *http.Response) (*http.Response, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = &etagEntry{
+		etag:     resp.Header.Get("ETag"),
+		body:     body,
+		header:   resp.Header,
+		lastUsed: c.now(),
+	}
+	for len(c.entries) > c.maxEntries {
+		c.evictOldest()
+	}
+	return resp, nil
+}
+
+// evictOldest drops the least recently used entry. Callers have to hold
+// the mutex
+func (c *etagCache) evictOldest() {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if oldestKey == "" || entry.lastUsed.Before(oldest) {
+			oldestKey = key
+			oldest = entry.lastUsed
+		}
+	}
+	delete(c.entries, oldestKey)
+}
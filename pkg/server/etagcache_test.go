@@ -0,0 +1,141 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// etagTransport serves a canned body with an ETag and answers matching
+// If-None-Match validators with 304 Not Modified
+type etagTransport struct {
+	etag     string
+	body     string
+	requests []string
+}
+
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req.Header.Get("If-None-Match"))
+
+	if req.Header.Get("If-None-Match") == t.etag {
+		return &http.Response{
+			StatusCode: http.StatusNotModified,
+			Header:     http.Header{"X-Ratelimit-Remaining": []string{"42"}},
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Etag": []string{t.etag}},
+		Body:       ioutil.NopCloser(strings.NewReader(t.body)),
+	}, nil
+}
+
+func cacheGet(t *testing.T, cache *etagCache, url string) *http.Response {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := cache.RoundTrip(req)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestETagCache(t *testing.T) {
+	t.Run("Revalidated response replays the cached body", func(t *testing.T) {
+		// Given a cached response for the URL
+		transport := &etagTransport{etag: `"v1"`, body: `{"items":[]}`}
+		cache := newETagCache(transport, 10)
+		first := cacheGet(t, cache, "http://github.example/repos")
+		require.NoError(t, first.Body.Close())
+
+		// When the same URL is requested again
+		second := cacheGet(t, cache, "http://github.example/repos")
+		body, err := ioutil.ReadAll(second.Body)
+		require.NoError(t, err)
+		require.NoError(t, second.Body.Close())
+
+		// Then the validator goes out and the 304 comes back as the
+		// full response, carrying the fresh rate limit headers
+		assert.Equal(t, []string{"", `"v1"`}, transport.requests)
+		assert.Equal(t, http.StatusOK, second.StatusCode)
+		assert.Equal(t, `{"items":[]}`, string(body))
+		assert.Equal(t, "42", second.Header.Get("X-RateLimit-Remaining"))
+	})
+
+	t.Run("Changed response replaces the cached one", func(t *testing.T) {
+		// Given a cached response whose upstream data changed
+		transport := &etagTransport{etag: `"v1"`, body: "before"}
+		cache := newETagCache(transport, 10)
+		first := cacheGet(t, cache, "http://github.example/repos")
+		require.NoError(t, first.Body.Close())
+		transport.etag = `"v2"`
+		transport.body = "after"
+
+		// When the URL is requested again
+		second := cacheGet(t, cache, "http://github.example/repos")
+		body, err := ioutil.ReadAll(second.Body)
+		require.NoError(t, err)
+		require.NoError(t, second.Body.Close())
+
+		// Then the new body is served and cached under the new validator
+		assert.Equal(t, "after", string(body))
+		third := cacheGet(t, cache, "http://github.example/repos")
+		require.NoError(t, third.Body.Close())
+		assert.Equal(t, []string{"", `"v1"`, `"v2"`}, transport.requests)
+	})
+
+	t.Run("Only GET requests take part in the cache", func(t *testing.T) {
+		// Given a cache in front of the transport
+		transport := &etagTransport{etag: `"v1"`, body: "payload"}
+		cache := newETagCache(transport, 10)
+
+		// When a POST request goes out twice
+		for i := 0; i < 2; i++ {
+			req, err := http.NewRequest(http.MethodPost, "http://github.example/repos", nil)
+			require.NoError(t, err)
+			resp, err := cache.RoundTrip(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+		}
+
+		// Then no validator is ever attached
+		assert.Equal(t, []string{"", ""}, transport.requests)
+	})
+
+	t.Run("Least recently used entry is evicted at the size bound", func(t *testing.T) {
+		// Given a cache of one entry holding the first URL
+		transport := &etagTransport{etag: `"v1"`, body: "payload"}
+		cache := newETagCache(transport, 1)
+		now := time.Now()
+		cache.now = func() time.Time { now = now.Add(time.Second); return now }
+		first := cacheGet(t, cache, "http://github.example/first")
+		require.NoError(t, first.Body.Close())
+
+		// When a second URL fills the only slot
+		second := cacheGet(t, cache, "http://github.example/second")
+		require.NoError(t, second.Body.Close())
+
+		// Then the first URL is requested without a validator again
+		third := cacheGet(t, cache, "http://github.example/first")
+		require.NoError(t, third.Body.Close())
+		assert.Equal(t, []string{"", "", ""}, transport.requests)
+	})
+}
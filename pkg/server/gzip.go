@@ -0,0 +1,127 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+)
+
+// gzipMinSize is the response size from which compression pays off.
+// Below it the gzip header and checksum outweigh the savings.
+const gzipMinSize = 1024
+
+// gzipHandler compresses responses for clients that accept gzip.
+// Responses smaller than gzipMinSize and responses that already carry
+// a Content-Encoding stay uncompressed.
+func gzipHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.Contains(req.Header.Get("Accept-Encoding"), "gzip") {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		gw := &gzipResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		defer gw.finish()
+		next.ServeHTTP(gw, req)
+	})
+}
+
+// gzipResponseWriter buffers the response until it is clear whether
+// compressing is worth it
+type gzipResponseWriter struct {
+	http.ResponseWriter
+
+	status int
+	buf    []byte
+	gz     *gzip.Writer
+	plain  bool
+}
+
+func (w *gzipResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *gzipResponseWriter) Write(p []byte) (int, error) {
+	if w.gz != nil {
+		return w.gz.Write(p)
+	}
+	if w.plain {
+		return w.ResponseWriter.Write(p)
+	}
+
+	w.buf = append(w.buf, p...)
+	if len(w.buf) >= gzipMinSize {
+		// Handlers that compress on their own, such as promhttp, are
+		// passed through untouched
+		if w.Header().Get("Content-Encoding") != "" {
+			w.flushPlain()
+			return len(p), nil
+		}
+		if err := w.startGzip(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush hands the response to the client immediately. A response that
+// is still buffered goes out uncompressed because streaming handlers,
+// such as the SSE endpoint, need every event on the wire right away.
+func (w *gzipResponseWriter) Flush() {
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	} else {
+		w.flushPlain()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// finish emits whatever is buffered once the handler returned
+func (w *gzipResponseWriter) finish() {
+	if w.gz != nil {
+		_ = w.gz.Close()
+		return
+	}
+	w.flushPlain()
+}
+
+// startGzip switches the buffered response over to compressed output
+func (w *gzipResponseWriter) startGzip() error {
+	w.Header().Set("Content-Encoding", "gzip")
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.status)
+	w.gz = gzip.NewWriter(w.ResponseWriter)
+
+	_, err := w.gz.Write(w.buf)
+	w.buf = nil
+	return err
+}
+
+// flushPlain emits the buffered response uncompressed
+func (w *gzipResponseWriter) flushPlain() {
+	if w.plain {
+		return
+	}
+	w.plain = true
+	w.ResponseWriter.WriteHeader(w.status)
+	if len(w.buf) > 0 {
+		_, _ = w.ResponseWriter.Write(w.buf)
+		w.buf = nil
+	}
+}
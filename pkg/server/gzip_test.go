@@ -0,0 +1,107 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGzipHandler(t *testing.T) {
+	largeBody := strings.Repeat("contributor ", 512)
+
+	t.Run("Large responses are compressed for gzip clients", func(t *testing.T) {
+		// Given a handler producing a response above the threshold
+		handler := gzipHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte(largeBody))
+		}))
+
+		// When a client that accepts gzip asks for it
+		req := httptest.NewRequest(http.MethodGet, "/v1alpha1/committer", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		// Then the response is gzip encoded and decodes to the body
+		require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+		gr, err := gzip.NewReader(bytes.NewReader(rec.Body.Bytes()))
+		require.NoError(t, err)
+		decoded, err := ioutil.ReadAll(gr)
+		require.NoError(t, err)
+		assert.Equal(t, largeBody, string(decoded))
+		assert.True(t, rec.Body.Len() < len(largeBody))
+	})
+
+	t.Run("Small responses stay uncompressed", func(t *testing.T) {
+		// Given a handler producing a response below the threshold
+		handler := gzipHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte("ok"))
+		}))
+
+		// When a client that accepts gzip asks for it
+		req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		// Then the response goes out as is
+		assert.Empty(t, rec.Header().Get("Content-Encoding"))
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Equal(t, "ok", rec.Body.String())
+	})
+
+	t.Run("Clients without gzip support get the identity encoding", func(t *testing.T) {
+		// Given a handler producing a response above the threshold
+		handler := gzipHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte(largeBody))
+		}))
+
+		// When a client without Accept-Encoding asks for it
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1alpha1/committer", nil))
+
+		// Then the response goes out as is
+		assert.Empty(t, rec.Header().Get("Content-Encoding"))
+		assert.Equal(t, largeBody, rec.Body.String())
+	})
+
+	t.Run("Flushed responses stream uncompressed", func(t *testing.T) {
+		// Given a streaming handler that flushes every event
+		handler := gzipHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte("event: contributor\n\n"))
+			w.(http.Flusher).Flush()
+			_, _ = w.Write([]byte(largeBody))
+		}))
+
+		// When a client that accepts gzip asks for it
+		req := httptest.NewRequest(http.MethodGet, "/v1alpha1/committer/stream", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		// Then the response stays uncompressed so events arrive right away
+		assert.Empty(t, rec.Header().Get("Content-Encoding"))
+		assert.True(t, strings.HasPrefix(rec.Body.String(), "event: contributor\n\n"))
+	})
+}
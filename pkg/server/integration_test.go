@@ -0,0 +1,398 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+)
+
+// waitForServer polls the health endpoint until the server accepts
+// requests or the deadline passes
+func waitForServer(t *testing.T, addr string) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := http.Get(fmt.Sprintf("http://%s/healthz", addr))
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("server did not start in time")
+}
+
+func TestIntegration_MockBackend(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	// Given running server backed by the mock backend
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+
+	srv, err := NewServer(listener,
+		WithBackend("mock"),
+		WithCapacity(10),
+		WithRate(25),
+		WithShutdownTimeout(time.Second))
+	require.NoError(t, err)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve()
+	}()
+	defer func() {
+		require.NoError(t, srv.Shutdown(context.Background()))
+		require.NoError(t, <-serveErr)
+	}()
+
+	waitForServer(t, addr)
+
+	t.Run("The gRPC endpoint serves the fixture", func(t *testing.T) {
+		// Given gRPC connection to the server
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		// When most active committer is called
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		resp, err := pb.NewCommitterServiceClient(conn).MostActiveCommitter(ctx, &pb.CommitterRequest{
+			Language: "go",
+		})
+
+		// Then the fixture contributors are returned in commit order,
+		// together with the language flavored sample repository ones
+		require.NoError(t, err)
+		require.Len(t, resp.Contributors, 4)
+		assert.Equal(t, "first-mock-user", resp.Contributors[0].Name)
+		assert.Equal(t, uint64(42), resp.Contributors[0].Commits)
+	})
+
+	t.Run("The SSE endpoint streams the fixture", func(t *testing.T) {
+		// When the streaming endpoint is requested over HTTP
+		resp, err := http.Get(fmt.Sprintf("http://%s/v1alpha1/committer/stream?language=go", addr))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Then the committers arrive as server sent events
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `data: {"name":"first-mock-user"`)
+	})
+
+	t.Run("The REST endpoint serves the fixture", func(t *testing.T) {
+		// When most active committer is requested over the gateway
+		resp, err := http.Get(fmt.Sprintf("http://%s/v1alpha1/committer?language=go", addr))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Then the fixture contributors are returned as JSON
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "first-mock-user")
+		assert.Contains(t, string(body), "second-mock-user")
+	})
+}
+
+func TestIntegration_UnixSocket(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	// Given running server listening on a unix domain socket
+	dir, err := ioutil.TempDir("", "committer-socket")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "server.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	srv, err := NewServer(listener,
+		WithBackend("mock"),
+		WithCapacity(10),
+		WithRate(25),
+		WithTracingDisabled(),
+		WithMetricsDisabled(),
+		WithShutdownTimeout(time.Second))
+	require.NoError(t, err)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve()
+	}()
+	defer func() {
+		require.NoError(t, srv.Shutdown(context.Background()))
+		require.NoError(t, <-serveErr)
+	}()
+
+	// And HTTP client that dials the socket instead of TCP
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := client.Get("http://unix/healthz")
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Run("The REST endpoint serves the fixture over the socket", func(t *testing.T) {
+		// When most active committer is requested over the gateway
+		resp, err := client.Get("http://unix/v1alpha1/committer?language=go")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Then the fixture contributors are returned as JSON
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "first-mock-user")
+	})
+
+	t.Run("The SSE endpoint streams the fixture over the socket", func(t *testing.T) {
+		// When the streaming endpoint is requested over HTTP
+		resp, err := client.Get("http://unix/v1alpha1/committer/stream?language=go")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Then the committers arrive as server sent events
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	})
+}
+
+// fakeRepoGetter serves a single repository for every search
+type fakeRepoGetter struct{}
+
+func (fakeRepoGetter) Repositories(ctx context.Context, query string,
+	opt *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+	name := "injected-repo"
+	owner := "injected-owner"
+	return &github.RepositoriesSearchResult{
+		Repositories: []github.Repository{
+			{Name: &name, Owner: &github.User{Login: &owner}},
+		},
+	}, &github.Response{}, nil
+}
+
+// fakeContributorsGetter serves a single contributor for every repository
+type fakeContributorsGetter struct{}
+
+func (fakeContributorsGetter) ListContributors(ctx context.Context, owner string, repoName string,
+	opt *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error) {
+	login := "injected-user"
+	contributions := 7
+	return []*github.Contributor{
+		{Login: &login, Contributions: &contributions},
+	}, &github.Response{}, nil
+}
+
+func TestIntegration_InjectedGetters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	// Given running server with injected data sources instead of the
+	// GitHub client
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+
+	srv, err := NewServer(listener,
+		WithRepositoryGetter(fakeRepoGetter{}),
+		WithContributorsGetter(fakeContributorsGetter{}),
+		WithCapacity(10),
+		WithRate(25),
+		// The Jaeger metrics can only be registered once per process
+		// and the mock backend test already did
+		WithTracingDisabled(),
+		WithMetricsDisabled(),
+		WithShutdownTimeout(time.Second))
+	require.NoError(t, err)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve()
+	}()
+	defer func() {
+		require.NoError(t, srv.Shutdown(context.Background()))
+		require.NoError(t, <-serveErr)
+	}()
+
+	waitForServer(t, addr)
+
+	t.Run("The REST endpoint serves the injected data", func(t *testing.T) {
+		// When most active committer is requested over the gateway
+		resp, err := http.Get(fmt.Sprintf("http://%s/v1alpha1/committer?language=go", addr))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Then the fake contributor is returned without any GitHub call
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "injected-user")
+	})
+}
+
+func TestIntegration_ObservabilityDisabled(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	// Given running server with tracing and metrics disabled
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+
+	srv, err := NewServer(listener,
+		WithBackend("mock"),
+		WithCapacity(10),
+		WithRate(25),
+		WithTracingDisabled(),
+		WithMetricsDisabled(),
+		WithShutdownTimeout(time.Second))
+	require.NoError(t, err)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve()
+	}()
+	defer func() {
+		require.NoError(t, srv.Shutdown(context.Background()))
+		require.NoError(t, <-serveErr)
+	}()
+
+	waitForServer(t, addr)
+
+	t.Run("The interceptor chain still serves requests", func(t *testing.T) {
+		// When most active committer is requested over REST
+		resp, err := http.Get(fmt.Sprintf("http://%s/v1alpha1/committer?language=go", addr))
+
+		// Then the fixture response is served
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("The metrics endpoint is not served", func(t *testing.T) {
+		// When the metrics endpoint is requested
+		resp, err := http.Get(fmt.Sprintf("http://%s/metrics", addr))
+
+		// Then it reports not found
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestIntegration_TracerInitFailure(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	// Given a server whose tracer can not be created because the
+	// service name is empty
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+
+	srv, err := NewServer(listener,
+		WithServerName(""),
+		WithBackend("mock"),
+		WithCapacity(10),
+		WithRate(25),
+		WithShutdownTimeout(time.Second))
+	require.NoError(t, err)
+
+	// When the server is started
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve()
+	}()
+	defer func() {
+		require.NoError(t, srv.Shutdown(context.Background()))
+		require.NoError(t, <-serveErr)
+	}()
+
+	// Then it serves requests despite the tracer failure
+	waitForServer(t, addr)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/v1alpha1/committer?language=go", addr))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestIntegration_StrictTracing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	// Given a strict tracing server whose tracer can not be created
+	// because the service name is empty
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	srv, err := NewServer(listener,
+		WithServerName(""),
+		WithStrictTracing(),
+		WithBackend("mock"),
+		WithCapacity(10),
+		WithRate(25))
+	require.NoError(t, err)
+
+	// When the server is started
+	err = srv.Serve()
+
+	// Then the start is aborted instead of degrading to a noop tracer
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "initializing tracer")
+}
@@ -0,0 +1,102 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/google/go-github/github"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// githubRateRemaining exports the most recently observed remaining
+// GitHub API quota so operators can alert before it is exhausted
+var githubRateRemaining = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "github_rate_limit_remaining",
+	Help: "The most recently observed remaining GitHub API quota.",
+})
+
+// committerQueries counts the committer queries broken down by the
+// requested language
+var committerQueries = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "committer_queries_total",
+	Help: "The number of committer queries by requested language.",
+}, []string{"language"})
+
+// committerContributorsReturned tracks how many contributors a query
+// found before pagination
+var committerContributorsReturned = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Name:    "committer_contributors_returned",
+	Help:    "The number of contributors found per committer query.",
+	Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+})
+
+// githubAPICalls counts outbound GitHub API calls by their type,
+// either "search" or "contributors"
+var githubAPICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "github_api_calls_total",
+	Help: "The number of GitHub API calls by call type.",
+}, []string{"type"})
+
+// cacheHits and cacheMisses count how often committer queries were
+// answered from the response cache, broken down by language so the TTL
+// can be tuned against the queries that benefit most
+var cacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "committer_cache_hits_total",
+	Help: "The number of committer queries answered from the cache by language.",
+}, []string{"language"})
+
+var cacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "committer_cache_misses_total",
+	Help: "The number of committer queries that missed the cache by language.",
+}, []string{"language"})
+
+// grpcPanics counts the panics recovered in the gRPC handler chain so
+// operators can alert on them instead of only seeing Internal errors
+var grpcPanics = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "grpc_panics_total",
+	Help: "The number of panics recovered in gRPC handlers.",
+})
+
+// defaultLatencyBuckets covers the sub-second to multi-second range
+// GitHub API calls typically fall into
+var defaultLatencyBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// githubAPICallDuration times every outbound GitHub API call by type
+var githubAPICallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "github_api_call_duration_seconds",
+	Help:    "The latency of outbound GitHub API calls by call type.",
+	Buckets: defaultLatencyBuckets,
+}, []string{"type"})
+
+func init() {
+	prometheus.MustRegister(
+		githubRateRemaining,
+		committerQueries,
+		committerContributorsReturned,
+		githubAPICalls,
+		githubAPICallDuration,
+		cacheHits,
+		cacheMisses,
+		grpcPanics,
+	)
+}
+
+// observeRateLimit records the rate limit information carried by a
+// GitHub response. Responses without rate information are skipped.
+func observeRateLimit(resp *github.Response) {
+	if resp == nil || resp.Rate.Limit == 0 {
+		return
+	}
+	githubRateRemaining.Set(float64(resp.Rate.Remaining))
+}
@@ -0,0 +1,146 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-github/github"
+)
+
+const (
+	// backendGitHub serves responses from the real GitHub API
+	backendGitHub = "github"
+
+	// backendMock serves deterministic in-memory responses so the
+	// full server can be exercised without GitHub access
+	backendMock = "mock"
+)
+
+// mockFixture seeds the mock backend with deterministic repositories
+// and their contributors
+type mockFixture struct {
+	repositories []github.Repository
+	contributors map[string][]*github.Contributor
+}
+
+// defaultMockFixture returns the fixture used by the mock backend. The
+// data is stable between runs so integration tests can assert on it.
+func defaultMockFixture() mockFixture {
+	owner := "mock-org"
+	repoName := "mock-repo"
+	firstLogin, secondLogin := "first-mock-user", "second-mock-user"
+	firstCommits, secondCommits := 42, 7
+
+	return mockFixture{
+		repositories: []github.Repository{
+			{
+				Owner: &github.User{Login: &owner},
+				Name:  &repoName,
+			},
+		},
+		contributors: map[string][]*github.Contributor{
+			owner + "/" + repoName: {
+				{
+					Login:         &firstLogin,
+					Contributions: &firstCommits,
+				},
+				{
+					Login:         &secondLogin,
+					Contributions: &secondCommits,
+				},
+			},
+		},
+	}
+}
+
+// fixtureRepositoryGetter serves the fixture repositories for every
+// query. A language qualified query additionally gets a deterministic
+// language flavored sample repository, so any language a client asks
+// for produces realistic looking data
+type fixtureRepositoryGetter struct {
+	fixture mockFixture
+}
+
+// nolint
+func (g fixtureRepositoryGetter) Repositories(ctx context.Context, query string,
+	opt *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+
+	repositories := g.fixture.repositories
+	if language := queriedLanguage(query); language != "" {
+		owner := "mock-org"
+		name := language + "-sample-project"
+		stars := 100 + len(language)
+		repositories = append(repositories[:len(repositories):len(repositories)], github.Repository{
+			Owner:           &github.User{Login: &owner},
+			Name:            &name,
+			Language:        &language,
+			StargazersCount: &stars,
+		})
+	}
+
+	return &github.RepositoriesSearchResult{
+		Repositories: repositories,
+	}, &github.Response{}, nil
+}
+
+// queriedLanguage extracts the language qualifier from a GitHub search
+// query. It is empty when the query has none
+func queriedLanguage(query string) string {
+	for _, qualifier := range strings.Fields(query) {
+		if strings.HasPrefix(qualifier, "language:") {
+			return strings.TrimPrefix(qualifier, "language:")
+		}
+	}
+	return ""
+}
+
+// fixtureContributorsGetter serves the fixture contributors of a repository
+type fixtureContributorsGetter struct {
+	fixture mockFixture
+}
+
+// nolint
+func (g fixtureContributorsGetter) ListContributors(ctx context.Context, owner string, repoName string,
+	opt *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error) {
+
+	if contributors, ok := g.fixture.contributors[owner+"/"+repoName]; ok {
+		return contributors, &github.Response{}, nil
+	}
+
+	// The language flavored sample repositories are not seeded in the
+	// fixture, their contributors are derived from the repository name
+	// so they stay deterministic between runs
+	lead, helper := repoName+"-maintainer", repoName+"-contributor"
+	leadCommits, helperCommits := 21, 3
+	return []*github.Contributor{
+		{
+			Login:         &lead,
+			Contributions: &leadCommits,
+		},
+		{
+			Login:         &helper,
+			Contributions: &helperCommits,
+		},
+	}, &github.Response{}, nil
+}
+
+// newMockBackendGetters builds the deterministic getters that replace
+// the GitHub client when the mock backend is selected
+func newMockBackendGetters() (RepositoryGetter, ContributorsGetter) {
+	fixture := defaultMockFixture()
+	return fixtureRepositoryGetter{fixture: fixture}, fixtureContributorsGetter{fixture: fixture}
+}
@@ -0,0 +1,81 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockBackend(t *testing.T) {
+	t.Run("A language query gets a language flavored sample repository", func(t *testing.T) {
+		// Given the mock backend getters
+		rg, _ := newMockBackendGetters()
+
+		// When repositories are searched for a language
+		result, _, err := rg.Repositories(context.TODO(), "language:rust", nil)
+
+		// Then the fixture is extended with a rust sample repository
+		require.NoError(t, err)
+		require.Len(t, result.Repositories, 2)
+		sample := result.Repositories[1]
+		assert.Equal(t, "rust-sample-project", sample.GetName())
+		assert.Equal(t, "rust", sample.GetLanguage())
+	})
+
+	t.Run("A query without a language serves only the fixture", func(t *testing.T) {
+		// Given the mock backend getters
+		rg, _ := newMockBackendGetters()
+
+		// When repositories are searched without a language qualifier
+		result, _, err := rg.Repositories(context.TODO(), "org:mock-org", nil)
+
+		// Then only the seeded fixture repository is served
+		require.NoError(t, err)
+		require.Len(t, result.Repositories, 1)
+		assert.Equal(t, "mock-repo", result.Repositories[0].GetName())
+	})
+
+	t.Run("Sample repository contributors are deterministic", func(t *testing.T) {
+		// Given the mock backend getters
+		_, cg := newMockBackendGetters()
+
+		// When the contributors of a sample repository are listed twice
+		first, _, err := cg.ListContributors(context.TODO(), "mock-org", "rust-sample-project", nil)
+		require.NoError(t, err)
+		second, _, err := cg.ListContributors(context.TODO(), "mock-org", "rust-sample-project", nil)
+		require.NoError(t, err)
+
+		// Then both runs serve the same derived contributors
+		require.Len(t, first, 2)
+		assert.Equal(t, "rust-sample-project-maintainer", first[0].GetLogin())
+		assert.Equal(t, 21, first[0].GetContributions())
+		assert.Equal(t, "rust-sample-project-contributor", first[1].GetLogin())
+		assert.Equal(t, 3, first[1].GetContributions())
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("WithFakeData selects the mock backend", func(t *testing.T) {
+		// Given new server with fake data
+		srv, err := NewServer(&mockListener{}, WithFakeData())
+
+		// Then the mock backend is selected
+		require.NoError(t, err)
+		assert.Equal(t, backendMock, srv.backend)
+	})
+}
@@ -0,0 +1,488 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Option modifies properties of a Server. Can be used to set
+// optional provider params, such as server name or logger. It is the
+// single functional option type of the package, every With* constructor
+// in this file returns one
+type Option func(provider *Server)
+
+// WithServerName if set to true, then it will start server with TLS encryption
+func WithServerName(n string) Option {
+	return func(s *Server) {
+		s.serverName = n
+	}
+}
+
+// WithLogger creates an option that assigns a logger to an instance of Server
+func WithLogger(l *zap.Logger) Option {
+	return func(s *Server) {
+		s.logger = l
+	}
+}
+
+// WithCertFile if set  where the certificate should be found
+func WithCertFile(c string) Option {
+	return func(s *Server) {
+		s.secureCfg.certFile = c
+	}
+}
+
+// WithCertFile setup where the key should be found
+func WithKeyFile(k string) Option {
+	return func(s *Server) {
+		s.secureCfg.keyFile = k
+	}
+}
+
+// WithCertPEM provides the server certificate as in-memory PEM bytes,
+// for example from an environment variable or a mounted secret. It
+// takes precedence over the certificate file
+func WithCertPEM(pem []byte) Option {
+	return func(s *Server) {
+		s.secureCfg.certPEM = pem
+	}
+}
+
+// WithKeyPEM provides the server private key as in-memory PEM bytes.
+// It takes precedence over the key file
+func WithKeyPEM(pem []byte) Option {
+	return func(s *Server) {
+		s.secureCfg.keyPEM = pem
+	}
+}
+
+// WithClientCAFile enables mutual TLS. Clients have to present a
+// certificate signed by a CA found in the given file. One way TLS stays
+// in place when empty
+func WithClientCAFile(path string) Option {
+	return func(s *Server) {
+		s.secureCfg.clientCAFile = path
+	}
+}
+
+// WithSecure if set to true, then it will start server with TLS encryption
+func WithSecure(s bool) Option {
+	return func(srv *Server) {
+		srv.secureCfg.secure = s
+	}
+}
+
+// WithCapacity setup maximum server request capacity
+func WithCapacity(c int64) Option {
+	return func(s *Server) {
+		s.capacity = c
+	}
+}
+
+// WithRate setup per second request rate
+func WithRate(r int64) Option {
+	return func(s *Server) {
+		s.rate = r
+	}
+}
+
+// WithRateLimitAlgorithm selects how requests are rate limited, either
+// AlgorithmTokenBucket which allows bursts up to the capacity, or
+// AlgorithmSlidingWindow which strictly caps requests at the rate
+// within any rolling second. The token bucket is the default.
+func WithRateLimitAlgorithm(algo string) Option {
+	return func(s *Server) {
+		s.rateLimitAlgo = algo
+	}
+}
+
+// WithGlobalRateLimit shares a single rate limiter bucket across all
+// clients instead of the default per-client buckets, restoring the
+// behavior where the capacity and rate bound the whole instance
+func WithGlobalRateLimit() Option {
+	return func(s *Server) {
+		s.globalRateLimit = true
+	}
+}
+
+// WithDefaultMaxContributors setup the instance wide default for how
+// many contributors are returned when the request does not ask for a
+// specific number
+func WithDefaultMaxContributors(c int) Option {
+	return func(s *Server) {
+		s.defaultMaxContributors = c
+	}
+}
+
+// WithDefaultTopRepositories setup the instance wide number of top
+// rated repositories that are scanned for contributors
+func WithDefaultTopRepositories(r int) Option {
+	return func(s *Server) {
+		s.topRepositories = r
+	}
+}
+
+// WithBackend selects where the contributor data comes from. The
+// supported backends are "github", the default, and "mock" which serves
+// deterministic in-memory fixtures for integration tests
+func WithBackend(b string) Option {
+	return func(s *Server) {
+		s.backend = b
+	}
+}
+
+// WithFakeData serves deterministic sample repositories and
+// contributors instead of calling GitHub, so the gateway, the swagger
+// UI and clients can be exercised end to end without network or token
+// access. It selects the mock backend and never applies by default
+func WithFakeData() Option {
+	return func(s *Server) {
+		s.backend = backendMock
+	}
+}
+
+// WithRetry retries transient GitHub errors, such as 502, 503 or abuse
+// rate limits, up to maxAttempts times with exponential backoff and
+// jitter starting from baseDelay. Retries are disabled when maxAttempts
+// is not bigger than one
+func WithRetry(maxAttempts int, baseDelay time.Duration) Option {
+	return func(s *Server) {
+		s.retryMaxAttempts = maxAttempts
+		s.retryBaseDelay = baseDelay
+	}
+}
+
+// WithRequestTimeout bounds the total handler time of every incoming
+// unary call. Calls that exceed the deadline fail with DeadlineExceeded.
+// Unlike the upstream timeout, which only covers the GitHub calls, this
+// covers the whole request. No deadline is applied when zero
+func WithRequestTimeout(d time.Duration) Option {
+	return func(s *Server) {
+		s.requestTimeout = d
+	}
+}
+
+// WithUpstreamTimeout bounds every outbound GitHub call so a hanging
+// upstream cannot block requests indefinitely. No deadline is applied
+// when zero
+func WithUpstreamTimeout(d time.Duration) Option {
+	return func(s *Server) {
+		s.upstreamTimeout = d
+	}
+}
+
+// WithIncludeAnonymous setup the instance wide default for whether
+// anonymous contributors are counted. Individual requests can still
+// override it with the include_anonymous field
+func WithIncludeAnonymous(include bool) Option {
+	return func(s *Server) {
+		s.includeAnonymous = strconv.FormatBool(include)
+	}
+}
+
+// WithTLSOnlyMethods lists full gRPC method names that may only be
+// called over a TLS connection even when the server also accepts
+// cleartext h2c traffic
+func WithTLSOnlyMethods(methods ...string) Option {
+	return func(s *Server) {
+		if s.tlsOnlyMethods == nil {
+			s.tlsOnlyMethods = make(map[string]bool, len(methods))
+		}
+		for _, m := range methods {
+			s.tlsOnlyMethods[m] = true
+		}
+	}
+}
+
+// WithTracingEndpoint points the Jaeger reporter at the given agent
+// host and port, for example "jaeger-agent:6831", instead of relying
+// on the JAEGER_AGENT_* environment variables
+func WithTracingEndpoint(host string) Option {
+	return func(s *Server) {
+		s.tracingEndpoint = host
+	}
+}
+
+// WithTracingSampler selects the Jaeger sampler, for example "const"
+// with param 1 to trace everything or "probabilistic" with the
+// sampling rate as param
+func WithTracingSampler(samplerType string, param float64) Option {
+	return func(s *Server) {
+		s.tracingSamplerType = samplerType
+		s.tracingSamplerParam = param
+	}
+}
+
+// WithTracingDisabled skips the Jaeger tracer entirely and installs a
+// noop tracer, for setups that do not run a tracing backend
+func WithTracingDisabled() Option {
+	return func(s *Server) {
+		s.tracingDisabled = true
+	}
+}
+
+// WithStrictTracing makes a failed tracer initialization abort the
+// start instead of degrading to a noop tracer, for environments where
+// serving without traces is not acceptable
+func WithStrictTracing() Option {
+	return func(s *Server) {
+		s.strictTracing = true
+	}
+}
+
+// WithMetricsDisabled leaves Prometheus out of the interceptor chain
+// and does not serve the /metrics endpoint, for setups that do not
+// scrape the server
+func WithMetricsDisabled() Option {
+	return func(s *Server) {
+		s.metricsDisabled = true
+	}
+}
+
+// WithLatencyBuckets overrides the histogram buckets used for the gRPC
+// handling time metric, so the buckets can match the latency SLO of
+// the deployment. The defaults cover sub-second to multi-second
+// GitHub latencies
+func WithLatencyBuckets(buckets []float64) Option {
+	return func(s *Server) {
+		s.latencyBuckets = buckets
+	}
+}
+
+// WithHTTPTimeouts overrides the read, write and idle connection
+// timeouts of the HTTP server. Non-positive values keep the defaults,
+// which are always applied because unlimited timeouts leave the server
+// open to slowloris style attacks
+func WithHTTPTimeouts(read, write, idle time.Duration) Option {
+	return func(s *Server) {
+		s.readTimeout = read
+		s.writeTimeout = write
+		s.idleTimeout = idle
+	}
+}
+
+// WithShutdownTimeout bounds how long Shutdown waits for in-flight
+// requests to drain before terminating them forcefully
+func WithShutdownTimeout(d time.Duration) Option {
+	return func(s *Server) {
+		s.shutdownTimeout = d
+	}
+}
+
+// WithWarmupLanguages lists languages that are requested once at start
+// to prime the response cache. The /readyz endpoint reports ready only
+// after all of them were requested
+func WithWarmupLanguages(languages ...string) Option {
+	return func(s *Server) {
+		s.warmupLanguages = append(s.warmupLanguages, languages...)
+	}
+}
+
+// WithCache enables the response cache where identical requests within
+// the given TTL reuse prior results instead of calling the GitHub API
+func WithCache(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.cacheTTL = ttl
+	}
+}
+
+// WithWebhookSecret enables the cache invalidation webhook and sets the
+// shared token callers have to present in the X-Webhook-Token header.
+// The webhook stays disabled when empty
+func WithWebhookSecret(secret string) Option {
+	return func(s *Server) {
+		s.webhookSecret = secret
+	}
+}
+
+// WithMaxConnsPerIP bounds how many simultaneous connections a single
+// client IP may hold open. Excess connections are refused at the
+// listener level. No limit is applied when zero
+func WithMaxConnsPerIP(n int) Option {
+	return func(s *Server) {
+		s.maxConnsPerIP = n
+	}
+}
+
+// WithMaxRecvMsgSize raises the limit in bytes for messages the gRPC
+// server accepts over the 4MB default. Every in-flight message may hold
+// that much memory, so the limit trades memory headroom for larger
+// requests. Non-positive values keep the default
+func WithMaxRecvMsgSize(bytes int) Option {
+	return func(s *Server) {
+		if bytes > 0 {
+			s.maxRecvMsgSize = bytes
+		}
+	}
+}
+
+// WithMaxSendMsgSize raises the limit in bytes for messages the gRPC
+// server sends, so rankings with hundreds of contributors and their
+// repository metadata do not fail with ResourceExhausted. The same
+// memory tradeoff as WithMaxRecvMsgSize applies. Non-positive values
+// keep the default
+func WithMaxSendMsgSize(bytes int) Option {
+	return func(s *Server) {
+		if bytes > 0 {
+			s.maxSendMsgSize = bytes
+		}
+	}
+}
+
+// WithKeepalive makes the server ping idle connections every time
+// interval and drop them when the ping is not answered within timeout,
+// so connections silently cut by a load balancer are noticed. Clients
+// are allowed to ping at half the interval, tighter client settings get
+// their connection closed with ENHANCE_YOUR_CALM. Conservative values
+// such as two minutes with a twenty second timeout work behind most
+// load balancers. Keepalive stays at the gRPC defaults when zero
+func WithKeepalive(time, timeout time.Duration) Option {
+	return func(s *Server) {
+		s.keepaliveTime = time
+		s.keepaliveTimeout = timeout
+	}
+}
+
+// WithReflection registers the gRPC reflection service so tools like
+// grpcurl and evans can explore the API without the proto files. Kept
+// off by default for security conscious deployments
+func WithReflection() Option {
+	return func(s *Server) {
+		s.reflectionEnabled = true
+	}
+}
+
+// WithMaxConnections bounds how many connections the server accepts at
+// once in total, backstopping the per-IP limit. Excess connections wait
+// at the listener until a slot frees up. No limit is applied when zero
+func WithMaxConnections(n int) Option {
+	return func(s *Server) {
+		s.maxConnections = n
+	}
+}
+
+// WithMaxConcurrentStreams bounds how many streams a single HTTP/2
+// connection may multiplex, on the gRPC server and the h2c handler
+// alike. No limit is applied when zero
+func WithMaxConcurrentStreams(n uint32) Option {
+	return func(s *Server) {
+		s.maxConcurrentStreams = n
+	}
+}
+
+// WithContextDecorator registers a function that may enrich the context
+// of every incoming request, for example with trace baggage or feature
+// flags, before any handler runs
+func WithContextDecorator(decorate func(context.Context) context.Context) Option {
+	return func(s *Server) {
+		s.contextDecorator = decorate
+	}
+}
+
+// WithGitHubToken setup the GitHub personal access token used for
+// outbound API calls. The server stays unauthenticated when empty
+func WithGitHubToken(token string) Option {
+	return func(s *Server) {
+		s.githubToken = token
+	}
+}
+
+// WithGitHubBaseURL points the GitHub client at a GitHub Enterprise
+// Server instance, for example "https://github.example.com/api/v3/",
+// instead of github.com. The trailing slash may be omitted
+func WithGitHubBaseURL(u string) Option {
+	return func(s *Server) {
+		s.githubBaseURL = u
+	}
+}
+
+// WithInsecureUpstream skips TLS certificate verification on outbound
+// GitHub calls. Meant for development setups with a broken trust store,
+// never for production
+func WithInsecureUpstream() Option {
+	return func(s *Server) {
+		s.insecureUpstream = true
+	}
+}
+
+// WithHTTPClient supplies the HTTP client used for the GitHub API,
+// for example an httptest client in tests or one routed through a
+// corporate proxy. It takes precedence over the token, the token pool
+// and the HTTP cache, which all decorate the internally built client
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *Server) {
+		s.httpClient = client
+	}
+}
+
+// WithRepositoryGetter replaces the GitHub client as the source of
+// repository search results, so integration tests can drive the full
+// HTTP stack against a fake. The real client is used when unset
+func WithRepositoryGetter(g RepositoryGetter) Option {
+	return func(s *Server) {
+		s.repoGetter = g
+	}
+}
+
+// WithContributorsGetter replaces the GitHub client as the source of
+// repository contributors, the counterpart of WithRepositoryGetter
+func WithContributorsGetter(g ContributorsGetter) Option {
+	return func(s *Server) {
+		s.contributorsGetter = g
+	}
+}
+
+// WithHTTPCache stores up to size GitHub responses together with their
+// ETags and revalidates them with conditional requests. A 304 Not
+// Modified answer replays the cached body and does not count against
+// the GitHub rate limit. The cache stays disabled when size is zero
+func WithHTTPCache(size int) Option {
+	return func(s *Server) {
+		s.httpCacheSize = size
+	}
+}
+
+// WithGitHubTokenPool rotates outgoing GitHub API calls across several
+// personal access tokens to multiply the rate budget. A rate limited
+// token is paused until its limit resets. The pool takes precedence
+// over the single token
+func WithGitHubTokenPool(tokens []string) Option {
+	return func(s *Server) {
+		s.githubTokenPool = tokens
+	}
+}
+
+// WithMaxContributorPages bounds how many pages of contributors
+// are fetched from a single repository
+func WithMaxContributorPages(p int) Option {
+	return func(s *Server) {
+		s.maxContributorPages = p
+	}
+}
+
+// applyOpts applies a set of options to Server.
+func applyOpts(s *Server, opts []Option) {
+	for _, o := range opts {
+		if o != nil {
+			o(s)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+)
+
+// Ranker computes the most active committer ranking as a plain library
+// call, without running the gRPC or HTTP server. It shares its
+// implementation with the committer service so both produce identical
+// results.
+type Ranker struct {
+	service *committerService
+}
+
+// RankOptions describes a single ranking computation. The zero value of
+// every field falls back to the same defaults the gRPC request fields
+// use.
+type RankOptions struct {
+	// Language ranks the committers of a single language.
+	Language string
+	// Languages ranks several languages at once, grouping the
+	// committers in the results of the response.
+	Languages []string
+	// Organizations limits the search to the given GitHub
+	// organizations.
+	Organizations []string
+	// MaxContributors bounds how many committers are returned.
+	MaxContributors uint32
+	// IncludeAnonymous counts anonymous contributors as well.
+	IncludeAnonymous bool
+	// MinStars skips repositories below the star threshold.
+	MinStars uint32
+	// Ascending returns the ranking least active first.
+	Ascending bool
+	// RepoSort selects how the top rated repositories are picked.
+	RepoSort pb.RepoSort
+	// StrictLanguage drops repositories whose primary language
+	// differs from the requested one.
+	StrictLanguage bool
+	// Page and PageSize slice the sorted ranking.
+	Page     uint32
+	PageSize uint32
+}
+
+// NewRanker creates a Ranker that fetches repositories and contributors
+// through the given getters
+func NewRanker(repos RepositoryGetter, contributors ContributorsGetter) *Ranker {
+	return &Ranker{
+		service: &committerService{
+			logger:             zap.L(),
+			repoGetter:         repos,
+			contributorsGetter: contributors,
+		},
+	}
+}
+
+// Rank computes the ranking described by opts
+func (r *Ranker) Rank(ctx context.Context, opts RankOptions) (*pb.CommitterResponse, error) {
+	req := &pb.CommitterRequest{
+		Language:        opts.Language,
+		Languages:       opts.Languages,
+		Organizations:   opts.Organizations,
+		MaxContributors: opts.MaxContributors,
+		MinStars:        opts.MinStars,
+		Ascending:       opts.Ascending,
+		RepoSort:        opts.RepoSort,
+		StrictLanguage:  opts.StrictLanguage,
+		Page:            opts.Page,
+		PageSize:        opts.PageSize,
+	}
+	if opts.IncludeAnonymous {
+		req.IncludeAnonymous = "true"
+	}
+
+	return r.service.MostActiveCommitter(ctx, req)
+}
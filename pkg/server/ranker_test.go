@@ -0,0 +1,71 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+)
+
+func TestRanker_Rank(t *testing.T) {
+	testUser := "test user"
+	testRepo := "test repo"
+
+	t.Run("Ranker matches the gRPC handler results", func(t *testing.T) {
+		// Given empty context
+		ctx := context.TODO()
+
+		// And fake getters shared by the ranker and the service
+		rg := repositoryGetterSetup(ctx, "testlanguage", testUser, testRepo)
+		cg := contributorGetterSetup(ctx, testUser, testRepo, 1)
+
+		// And a ranker built on them
+		ranker := NewRanker(rg, cg)
+
+		// When the ranking is computed directly
+		got, err := ranker.Rank(ctx, RankOptions{Language: "testlanguage"})
+
+		// Then no error is returned
+		require.NoError(t, err)
+
+		// And the result equals what the gRPC handler produces
+		srv := &committerService{
+			logger:             zap.L(),
+			repoGetter:         rg,
+			contributorsGetter: cg,
+		}
+		want, err := srv.MostActiveCommitter(ctx, &pb.CommitterRequest{Language: "testlanguage"})
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Validation errors surface to library callers", func(t *testing.T) {
+		// Given a ranker without any getters
+		ranker := NewRanker(nil, nil)
+
+		// When the ranking is computed without a language
+		_, err := ranker.Rank(context.TODO(), RankOptions{})
+
+		// Then an error is returned
+		require.Error(t, err)
+		assert.Equal(t, pb.ErrorCode_INVALID_LANGUAGE, errorCode(t, err))
+	})
+}
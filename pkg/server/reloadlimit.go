@@ -0,0 +1,83 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	grpc_ratelimit "github.com/RafalKorepta/most-popular-committer/pkg/ratelimit"
+)
+
+// reloadableLimiter holds the rate limiter behind a swappable
+// indirection so SetRateLimit can apply new parameters at runtime
+// without restarting the server or rebuilding the interceptor chain.
+type reloadableLimiter struct {
+	mu      sync.RWMutex
+	limiter grpc_ratelimit.Limiter
+}
+
+// store swaps the inner limiter. In-flight requests keep using the
+// limiter they already loaded, the next request sees the new one.
+func (r *reloadableLimiter) store(l grpc_ratelimit.Limiter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limiter = l
+}
+
+func (r *reloadableLimiter) load() grpc_ratelimit.Limiter {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.limiter
+}
+
+// WaitMaxDuration takes one token from the current limiter
+func (r *reloadableLimiter) WaitMaxDuration(maxWaitDuration time.Duration) bool {
+	return r.load().WaitMaxDuration(maxWaitDuration)
+}
+
+// WaitNMaxDuration takes n tokens from the current limiter
+func (r *reloadableLimiter) WaitNMaxDuration(n int64, maxWaitDuration time.Duration) bool {
+	limiter := r.load()
+	if nl, ok := limiter.(grpc_ratelimit.NLimiter); ok {
+		return nl.WaitNMaxDuration(n, maxWaitDuration)
+	}
+	return limiter.WaitMaxDuration(maxWaitDuration)
+}
+
+// RetryAfter reports the retry estimate of the current limiter, or
+// zero when it cannot estimate one
+func (r *reloadableLimiter) RetryAfter() time.Duration {
+	if reporter, ok := r.load().(grpc_ratelimit.RetryAfterReporter); ok {
+		return reporter.RetryAfter()
+	}
+	return 0
+}
+
+// WaitKeyMaxDuration takes n tokens from the bucket of the given key.
+// It degrades to the unkeyed budget when the current limiter does not
+// keep per-key buckets.
+func (r *reloadableLimiter) WaitKeyMaxDuration(key string, n int64,
+	maxWaitDuration time.Duration) bool {
+
+	limiter := r.load()
+	if kl, ok := limiter.(grpc_ratelimit.KeyedLimiter); ok {
+		return kl.WaitKeyMaxDuration(key, n, maxWaitDuration)
+	}
+	if nl, ok := limiter.(grpc_ratelimit.NLimiter); ok {
+		return nl.WaitNMaxDuration(n, maxWaitDuration)
+	}
+	return limiter.WaitMaxDuration(maxWaitDuration)
+}
@@ -0,0 +1,141 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDHeader carries the ID correlating the REST request with its
+// gRPC handling across the gateway boundary
+const requestIDHeader = "X-Request-ID"
+
+// requestIDMetadataKey is the lower cased header name, the form gRPC
+// metadata keys take
+const requestIDMetadataKey = "x-request-id"
+
+// newRequestID draws a fresh random request ID
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand failing is not recoverable
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// requestIDHandler accepts or generates the X-Request-ID header and
+// echoes it back on the response, so callers can quote the ID when
+// reporting a problem. The header is written back into the request so
+// the gateway forwards it to the gRPC endpoint.
+func requestIDHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		id := req.Header.Get(requestIDHeader)
+		if id == "" {
+			id = newRequestID()
+			req.Header.Set(requestIDHeader, id)
+		}
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, req)
+	})
+}
+
+// requestIDHeaderMatcher forwards the X-Request-ID header into the
+// gRPC metadata under its own name instead of dropping it, which the
+// gateway does by default for non standard headers
+func requestIDHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, requestIDHeader) {
+		return requestIDMetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// requestIDUnaryInterceptor pulls the request ID out of the incoming
+// metadata, generating one for calls that arrived without it, and adds
+// it as a field to every log line of the request. It has to run after
+// the zap interceptor so the context carries a logger already.
+func requestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		id := requestIDFromMetadata(ctx)
+		if id == "" {
+			id = newRequestID()
+			md, ok := metadata.FromIncomingContext(ctx)
+			if !ok {
+				md = metadata.MD{}
+			} else {
+				md = md.Copy()
+			}
+			md.Set(requestIDMetadataKey, id)
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+		ctxzap.AddFields(ctx, zap.String("request_id", id))
+
+		return handler(ctx, req)
+	}
+}
+
+// requestIDStreamInterceptor is the streaming counterpart of
+// requestIDUnaryInterceptor. The stream is wrapped so the handler sees
+// the context carrying the generated ID.
+func requestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		ctx := stream.Context()
+		id := requestIDFromMetadata(ctx)
+		if id == "" {
+			id = newRequestID()
+			md, ok := metadata.FromIncomingContext(ctx)
+			if !ok {
+				md = metadata.MD{}
+			} else {
+				md = md.Copy()
+			}
+			md.Set(requestIDMetadataKey, id)
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+		ctxzap.AddFields(ctx, zap.String("request_id", id))
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// requestIDFromMetadata reads the request ID from the incoming
+// metadata
+func requestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md.Get(requestIDMetadataKey); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestIDHandler(t *testing.T) {
+	t.Run("Provided ID is forwarded and echoed back", func(t *testing.T) {
+		// Given a handler that records the forwarded header
+		var forwarded string
+		handler := requestIDHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			forwarded = req.Header.Get(requestIDHeader)
+		}))
+
+		// When a request carrying an ID is served
+		req := httptest.NewRequest(http.MethodGet, "/v1alpha1/committer", nil)
+		req.Header.Set(requestIDHeader, "abc-123")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		// Then the same ID reaches the handler and the response
+		assert.Equal(t, "abc-123", forwarded)
+		assert.Equal(t, "abc-123", rec.Header().Get(requestIDHeader))
+	})
+
+	t.Run("Missing ID is generated", func(t *testing.T) {
+		// Given a handler that records the forwarded header
+		var forwarded string
+		handler := requestIDHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			forwarded = req.Header.Get(requestIDHeader)
+		}))
+
+		// When a request without an ID is served
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1alpha1/committer", nil))
+
+		// Then a fresh ID reaches the handler and the response
+		assert.NotEmpty(t, forwarded)
+		assert.Equal(t, forwarded, rec.Header().Get(requestIDHeader))
+	})
+}
+
+func TestRequestIDHeaderMatcher(t *testing.T) {
+	t.Run("The request ID header is forwarded under its own name", func(t *testing.T) {
+		key, ok := requestIDHeaderMatcher("X-Request-Id")
+
+		require.True(t, ok)
+		assert.Equal(t, requestIDMetadataKey, key)
+	})
+
+	t.Run("Other headers keep the gateway default handling", func(t *testing.T) {
+		_, ok := requestIDHeaderMatcher("X-Custom-Header")
+
+		assert.False(t, ok)
+	})
+}
+
+func TestRequestIDUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	t.Run("The incoming metadata ID is kept", func(t *testing.T) {
+		// Given a context carrying a request ID
+		ctx := metadata.NewIncomingContext(context.TODO(),
+			metadata.Pairs(requestIDMetadataKey, "abc-123"))
+
+		// When the interceptor runs
+		var seen string
+		_, err := requestIDUnaryInterceptor()(ctx, nil, info,
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				seen = requestIDFromMetadata(ctx)
+				return nil, nil
+			})
+
+		// Then the handler sees the same ID
+		require.NoError(t, err)
+		assert.Equal(t, "abc-123", seen)
+	})
+
+	t.Run("A missing ID is generated", func(t *testing.T) {
+		// When the interceptor runs without incoming metadata
+		var seen string
+		_, err := requestIDUnaryInterceptor()(context.TODO(), nil, info,
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				seen = requestIDFromMetadata(ctx)
+				return nil, nil
+			})
+
+		// Then the handler sees a fresh ID
+		require.NoError(t, err)
+		assert.NotEmpty(t, seen)
+	})
+}
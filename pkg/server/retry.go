@@ -0,0 +1,136 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// defaultRetryBaseDelay is the first backoff delay when WithRetry does
+// not configure one
+const defaultRetryBaseDelay = 100 * time.Millisecond
+
+// retryPolicy retries transient GitHub failures with exponential
+// backoff and jitter
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+}
+
+// delay computes the backoff before the given retry. The delay doubles
+// with every attempt and half of it is randomized to avoid synchronized
+// retries
+func (p retryPolicy) delay(attempt int) time.Duration {
+	base := p.baseDelay
+	if base <= 0 {
+		base = defaultRetryBaseDelay
+	}
+
+	d := base << uint(attempt)
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1)) // nolint:gosec
+}
+
+// retriableError reports whether the GitHub call is worth retrying.
+// Server side errors and abuse rate limits are transient while client
+// errors are returned to the caller right away. Primary rate limits are
+// deliberately not retried, their reset is typically minutes away and
+// far beyond the backoff window, so the attempts would be burned on
+// guaranteed failures.
+func retriableError(resp *github.Response, err error) bool {
+	if _, ok := err.(*github.AbuseRateLimitError); ok {
+		return true
+	}
+	if resp == nil {
+		return false
+	}
+	return resp.StatusCode == http.StatusBadGateway ||
+		resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// do runs the call until it succeeds, fails with a non retriable error
+// or the attempts are exhausted. The backoff sleep respects context
+// cancellation and the last error is surfaced to the caller.
+func (p retryPolicy) do(ctx context.Context, call func() (*github.Response, error)) error {
+	var lastErr error
+	for attempt := 0; attempt < p.maxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(p.delay(attempt - 1)):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		resp, err := call()
+		if err == nil {
+			return nil
+		}
+
+		lastErr = err
+		if !retriableError(resp, err) {
+			return err
+		}
+	}
+	return lastErr
+}
+
+// retryingRepositoryGetter decorates a RepositoryGetter with the retry policy
+type retryingRepositoryGetter struct {
+	delegate RepositoryGetter
+	policy   retryPolicy
+}
+
+// nolint
+func (g retryingRepositoryGetter) Repositories(ctx context.Context, query string,
+	opt *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+
+	var (
+		result *github.RepositoriesSearchResult
+		resp   *github.Response
+	)
+	err := g.policy.do(ctx, func() (*github.Response, error) {
+		var callErr error
+		result, resp, callErr = g.delegate.Repositories(ctx, query, opt)
+		return resp, callErr
+	})
+	return result, resp, err
+}
+
+// retryingContributorsGetter decorates a ContributorsGetter with the retry policy
+type retryingContributorsGetter struct {
+	delegate ContributorsGetter
+	policy   retryPolicy
+}
+
+// nolint
+func (g retryingContributorsGetter) ListContributors(ctx context.Context, owner string, repoName string,
+	opt *github.ListContributorsOptions) ([]*github.Contributor, *github.Response, error) {
+
+	var (
+		result []*github.Contributor
+		resp   *github.Response
+	)
+	err := g.policy.do(ctx, func() (*github.Response, error) {
+		var callErr error
+		result, resp, callErr = g.delegate.ListContributors(ctx, owner, repoName, opt)
+		return resp, callErr
+	})
+	return result, resp, err
+}
@@ -0,0 +1,165 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyRepoGetter fails with the configured responses before succeeding
+type flakyRepoGetter struct {
+	calls     int
+	failures  []*github.Response
+	failError error
+}
+
+// nolint
+func (g *flakyRepoGetter) Repositories(ctx context.Context, query string,
+	opt *github.SearchOptions) (*github.RepositoriesSearchResult, *github.Response, error) {
+
+	g.calls++
+	if g.calls <= len(g.failures) {
+		return nil, g.failures[g.calls-1], g.failError
+	}
+	return &github.RepositoriesSearchResult{}, &github.Response{}, nil
+}
+
+func githubResponse(statusCode int) *github.Response {
+	return &github.Response{
+		Response: &http.Response{StatusCode: statusCode},
+	}
+}
+
+func TestRetryingRepositoryGetter(t *testing.T) {
+	policy := retryPolicy{
+		maxAttempts: 3,
+		baseDelay:   time.Millisecond,
+	}
+
+	t.Run("Transient failures are retried until success", func(t *testing.T) {
+		// Given GitHub that fails twice with 502 before succeeding
+		delegate := &flakyRepoGetter{
+			failures:  []*github.Response{githubResponse(http.StatusBadGateway), githubResponse(http.StatusBadGateway)},
+			failError: errors.New("bad gateway"),
+		}
+		getter := retryingRepositoryGetter{delegate: delegate, policy: policy}
+
+		// When repositories are queried
+		result, _, err := getter.Repositories(context.TODO(), "language:go", nil)
+
+		// Then the call eventually succeeds
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 3, delegate.calls)
+	})
+
+	t.Run("Client errors are not retried", func(t *testing.T) {
+		// Given GitHub that rejects the query
+		delegate := &flakyRepoGetter{
+			failures:  []*github.Response{githubResponse(http.StatusUnprocessableEntity)},
+			failError: errors.New("validation failed"),
+		}
+		getter := retryingRepositoryGetter{delegate: delegate, policy: policy}
+
+		// When repositories are queried
+		_, _, err := getter.Repositories(context.TODO(), "language:go", nil)
+
+		// Then the error is surfaced after a single attempt
+		require.Error(t, err)
+		assert.Equal(t, 1, delegate.calls)
+	})
+
+	t.Run("Last error is surfaced when attempts are exhausted", func(t *testing.T) {
+		// Given GitHub that keeps failing with 503
+		failure := githubResponse(http.StatusServiceUnavailable)
+		delegate := &flakyRepoGetter{
+			failures:  []*github.Response{failure, failure, failure},
+			failError: errors.New("unavailable"),
+		}
+		getter := retryingRepositoryGetter{delegate: delegate, policy: policy}
+
+		// When repositories are queried
+		_, _, err := getter.Repositories(context.TODO(), "language:go", nil)
+
+		// Then the last error is returned after all attempts
+		require.Error(t, err)
+		assert.Equal(t, "unavailable", err.Error())
+		assert.Equal(t, 3, delegate.calls)
+	})
+
+	t.Run("Abuse rate limit errors are retried", func(t *testing.T) {
+		// Given GitHub that reports an abuse rate limit once
+		delegate := &flakyRepoGetter{
+			failures:  []*github.Response{githubResponse(http.StatusForbidden)},
+			failError: &github.AbuseRateLimitError{},
+		}
+		getter := retryingRepositoryGetter{delegate: delegate, policy: policy}
+
+		// When repositories are queried
+		_, _, err := getter.Repositories(context.TODO(), "language:go", nil)
+
+		// Then the call eventually succeeds
+		require.NoError(t, err)
+		assert.Equal(t, 2, delegate.calls)
+	})
+
+	t.Run("Primary rate limit errors are not retried", func(t *testing.T) {
+		// Given GitHub that reports an exhausted primary rate limit
+		delegate := &flakyRepoGetter{
+			failures:  []*github.Response{githubResponse(http.StatusForbidden)},
+			failError: &github.RateLimitError{},
+		}
+		getter := retryingRepositoryGetter{delegate: delegate, policy: policy}
+
+		// When repositories are queried
+		_, _, err := getter.Repositories(context.TODO(), "language:go", nil)
+
+		// Then the error is surfaced after a single attempt, the reset
+		// is too far away for the backoff window
+		require.Error(t, err)
+		assert.Equal(t, 1, delegate.calls)
+	})
+
+	t.Run("Backoff respects context cancellation", func(t *testing.T) {
+		// Given cancelled context and GitHub that keeps failing
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		failure := githubResponse(http.StatusServiceUnavailable)
+		delegate := &flakyRepoGetter{
+			failures:  []*github.Response{failure, failure, failure},
+			failError: errors.New("unavailable"),
+		}
+		getter := retryingRepositoryGetter{delegate: delegate, policy: retryPolicy{
+			maxAttempts: 3,
+			baseDelay:   time.Minute,
+		}}
+
+		// When repositories are queried
+		_, _, err := getter.Repositories(ctx, "language:go", nil)
+
+		// Then the backoff is interrupted with the context error
+		require.Error(t, err)
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 1, delegate.calls)
+	})
+}
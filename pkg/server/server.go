@@ -0,0 +1,1331 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+	"github.com/RafalKorepta/most-popular-committer/pkg/certs"
+	"github.com/RafalKorepta/most-popular-committer/pkg/log"
+	grpc_ratelimit "github.com/RafalKorepta/most-popular-committer/pkg/ratelimit"
+	"github.com/RafalKorepta/most-popular-committer/pkg/ratelimit/slidingwindow"
+	"github.com/RafalKorepta/most-popular-committer/pkg/ratelimit/tokenbucket"
+	"github.com/RafalKorepta/most-popular-committer/pkg/ui"
+	"github.com/google/go-github/github"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/uber/jaeger-client-go/config"
+	"github.com/uber/jaeger-lib/metrics/prometheus"
+	"github.com/veqryn/h2c"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/netutil"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	serverDefaultName = "most-popular-committer"
+
+	// rateLimiterIdleTimeout bounds how long a per-client token bucket
+	// survives without traffic before its registry entry is evicted
+	rateLimiterIdleTimeout = 5 * time.Minute
+
+	// defaultReadTimeout, defaultWriteTimeout and defaultIdleTimeout
+	// guard the HTTP server against clients that hold connections open
+	// forever, such as slowloris attacks. The write timeout leaves room
+	// for slow GitHub round trips and the streaming endpoint
+	defaultReadTimeout  = 30 * time.Second
+	defaultWriteTimeout = 2 * time.Minute
+	defaultIdleTimeout  = 5 * time.Minute
+
+	// AlgorithmTokenBucket allows bursts up to the configured capacity
+	// that refill at the configured rate
+	AlgorithmTokenBucket = "tokenbucket"
+	// AlgorithmSlidingWindow strictly caps requests at the configured
+	// rate within any rolling second, without a burst allowance
+	AlgorithmSlidingWindow = "slidingwindow"
+)
+
+type SecureConfig struct {
+	secure       bool
+	certFile     string
+	keyFile      string
+	certPEM      []byte
+	keyPEM       []byte
+	clientCAFile string
+}
+
+type Server struct {
+	listener   net.Listener
+	serverName string
+	logger     *zap.Logger
+	health     *health.Server
+
+	secureCfg       SecureConfig
+	capacity        int64
+	rate            int64
+	globalRateLimit bool
+	rateLimitAlgo   string
+	rateMu          sync.Mutex
+	rateLimiter     *reloadableLimiter
+
+	maxContributorPages int
+	githubToken         string
+	githubTokenPool     []string
+	cacheTTL            time.Duration
+	tlsOnlyMethods      map[string]bool
+
+	defaultMaxContributors int
+	topRepositories        int
+	includeAnonymous       string
+	upstreamTimeout        time.Duration
+
+	retryMaxAttempts int
+	retryBaseDelay   time.Duration
+
+	backend       string
+	webhookSecret string
+	githubBaseURL string
+
+	// insecureUpstream skips TLS certificate verification on outbound
+	// GitHub calls. Strictly opt-in, verification is the default
+	insecureUpstream bool
+
+	// httpCacheSize bounds how many GitHub responses are kept for ETag
+	// revalidation. The cache stays disabled when zero
+	httpCacheSize int
+
+	// requestTimeout bounds the total handler time of every unary call.
+	// No deadline is applied when zero
+	requestTimeout time.Duration
+
+	// reflectionEnabled registers the gRPC reflection service so tools
+	// like grpcurl can introspect the API. Off by default
+	reflectionEnabled bool
+
+	// keepaliveTime and keepaliveTimeout tune the server side gRPC
+	// keepalive pings. Disabled when zero
+	keepaliveTime    time.Duration
+	keepaliveTimeout time.Duration
+
+	// maxRecvMsgSize and maxSendMsgSize override the gRPC message size
+	// limits in bytes. The gRPC defaults apply when zero
+	maxRecvMsgSize int
+	maxSendMsgSize int
+
+	// httpClient, when set, replaces the internally built GitHub HTTP
+	// client, for tests and proxy setups
+	httpClient *http.Client
+
+	// repoGetter and contributorsGetter, when set, replace the GitHub
+	// client as the data source of the committer service
+	repoGetter         RepositoryGetter
+	contributorsGetter ContributorsGetter
+
+	contextDecorator func(context.Context) context.Context
+	maxConnsPerIP    int
+
+	// maxConnections bounds how many connections are served at once in
+	// total, maxConcurrentStreams how many streams one HTTP/2
+	// connection may multiplex. Both are unlimited when zero
+	maxConnections       int
+	maxConcurrentStreams uint32
+
+	// githubPing performs a cheap authenticated GitHub call so the
+	// readiness probe can detect a bad token or no connectivity. Unset
+	// for backends that do not reach GitHub
+	githubPing func(ctx context.Context) error
+
+	tracingDisabled bool
+	// strictTracing makes a failed tracer initialization abort the
+	// start instead of degrading to a noop tracer
+	strictTracing       bool
+	tracingEndpoint     string
+	tracingSamplerType  string
+	tracingSamplerParam float64
+	metricsDisabled     bool
+	latencyBuckets      []float64
+
+	shutdownTimeout time.Duration
+
+	// readTimeout, writeTimeout and idleTimeout bound how long a
+	// connection may take to send a request, receive a response and sit
+	// idle between requests. The defaults apply when non-positive
+	readTimeout  time.Duration
+	writeTimeout time.Duration
+	idleTimeout  time.Duration
+
+	warmupLanguages []string
+	readyMu         sync.RWMutex
+	ready           bool
+	draining        bool
+
+	service pb.CommitterServiceServer
+	httpSrv *http.Server
+	grpcSrv *grpc.Server
+}
+
+// NewServer constructor of Server
+func NewServer(l net.Listener, opts ...Option) (*Server, error) {
+	if l == nil {
+		return nil, errors.New("missing listener")
+	}
+
+	srv := &Server{
+		listener:   l,
+		serverName: serverDefaultName,
+		logger:     zap.L(),
+		health:     health.NewServer(),
+	}
+	applyOpts(srv, opts)
+
+	switch srv.backend {
+	case "", backendGitHub, backendMock:
+	default:
+		return nil, errors.Errorf("unsupported backend %q", srv.backend)
+	}
+
+	switch srv.rateLimitAlgo {
+	case "", AlgorithmTokenBucket, AlgorithmSlidingWindow:
+	default:
+		return nil, errors.Errorf("unsupported rate limit algorithm %q", srv.rateLimitAlgo)
+	}
+
+	return srv, nil
+}
+
+// Check verifies the server could start with the current configuration
+// without binding a port or serving traffic. It constructs the rate
+// limiter and, when TLS is enabled, loads the certificates the same way
+// Serve would. Meant for deployment preflight checks.
+func (s *Server) Check() error {
+	_ = s.newRateLimiter()
+
+	if s.secureCfg.secure {
+		if _, err := s.serverTLSConfig(); err != nil {
+			return errors.Wrap(err, "loading TLS configuration")
+		}
+		if _, err := s.serverCertPool(); err != nil {
+			return errors.Wrap(err, "creating certificate pool")
+		}
+	}
+	return nil
+}
+
+// Serve will start gRPC and REST server on the same port with or without TLS
+func (s *Server) Serve() error {
+	if s.maxConnsPerIP > 0 {
+		s.listener = limitConnsPerIP(s.listener, s.maxConnsPerIP)
+	}
+	if s.maxConnections > 0 {
+		// The total bound backstops the per-IP one, so many distinct
+		// clients cannot exhaust the server either
+		s.listener = netutil.LimitListener(s.listener, s.maxConnections)
+	}
+
+	if s.tracingDisabled {
+		opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+	} else {
+		tracerCloser, err := s.initializeGlobalTracer(zap.L(), zap.S())
+		switch {
+		case err == nil:
+			defer tracerCloser.Close()
+		case s.strictTracing:
+			return errors.Wrap(err, "initializing tracer")
+		default:
+			// A missing Jaeger agent must not keep the server from
+			// running, tracing degrades to a noop tracer instead
+			zap.L().Warn("Tracer initialization failed, continuing without tracing", zap.Error(err))
+			opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+		}
+	}
+
+	var srv *http.Server
+	var err error
+	if s.secureCfg.secure {
+		srv, err = s.createHTTPSServer()
+		if err != nil {
+			return errors.Wrap(err, "crating https server")
+		}
+	} else {
+		srv, err = s.createHTTPServer()
+		if err != nil {
+			return errors.Wrap(err, "crating http server")
+		}
+	}
+
+	// Report readiness for health probes once the server starts accepting
+	// connections. The status flips to NOT_SERVING during shutdown.
+	s.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+
+	// Readiness is gated on the cache warm-up so load balancers do not
+	// route traffic that would miss the cache
+	if len(s.warmupLanguages) > 0 {
+		go s.warmUp(s.service)
+	} else {
+		s.setReady()
+	}
+
+	s.httpSrv = srv
+
+	defer s.listener.Close()
+	if s.secureCfg.secure {
+		err = srv.ServeTLS(s.listener, "", "") // The certificates are initialized already
+	} else {
+		err = srv.Serve(s.listener)
+	}
+	if err == http.ErrServerClosed {
+		// Shutdown was requested, not a failure
+		return nil
+	}
+
+	return err
+}
+
+// Shutdown gracefully stops the server. It marks the health service as
+// NOT_SERVING, drains in-flight HTTP requests and RPCs and closes the
+// listener. When a shutdown timeout is configured the remaining RPCs
+// are forcefully terminated after it elapses.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.health.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	s.setDraining()
+
+	if s.shutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.shutdownTimeout)
+		defer cancel()
+	}
+
+	var err error
+	if s.httpSrv != nil {
+		err = s.httpSrv.Shutdown(ctx)
+	}
+
+	if s.grpcSrv != nil {
+		stopped := make(chan struct{})
+		go func() {
+			s.grpcSrv.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			s.grpcSrv.Stop()
+		}
+	}
+
+	return err
+}
+
+// initializeGlobalTracer will set global tracer using jeager tracer
+func (s *Server) initializeGlobalTracer(logger *zap.Logger, sugar *zap.SugaredLogger) (io.Closer, error) {
+	zapWrapper := log.ZapWrapper{
+		Logger: logger,
+		Sugar:  sugar,
+	}
+
+	metricsFactory := prometheus.New()
+
+	cfg := config.Configuration{
+		ServiceName: s.serverName,
+	}
+	if s.tracingEndpoint != "" {
+		// Spans go to the given jaeger-agent instead of the address
+		// taken from the JAEGER_AGENT_* environment variables
+		cfg.Reporter = &config.ReporterConfig{LocalAgentHostPort: s.tracingEndpoint}
+	}
+	if s.tracingSamplerType != "" {
+		cfg.Sampler = &config.SamplerConfig{
+			Type:  s.tracingSamplerType,
+			Param: s.tracingSamplerParam,
+		}
+	}
+
+	tracer, closer, err := cfg.NewTracer(
+		config.Metrics(metricsFactory),
+		config.Logger(zapWrapper),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start tracer: %v", err)
+	}
+	opentracing.SetGlobalTracer(tracer)
+	return closer, nil
+}
+
+// githubHTTPClient creates the HTTP client used to talk to the GitHub API.
+// When a token is configured the client sends it as an OAuth2 bearer token
+// which raises the GitHub rate limits.
+func (s *Server) githubHTTPClient() *http.Client {
+	if s.httpClient != nil {
+		return s.httpClient
+	}
+
+	tr := &http.Transport{}
+	if s.insecureUpstream {
+		// Deliberately opt-in only, for setups such as docker on osx
+		// where the system trust store is broken
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // nolint:gosec
+	}
+
+	var base http.RoundTripper = tr
+	if s.httpCacheSize > 0 {
+		// Revalidated responses come back as 304 Not Modified which
+		// GitHub does not count against the rate limit
+		base = newETagCache(tr, s.httpCacheSize)
+	}
+
+	if len(s.githubTokenPool) > 0 {
+		return &http.Client{Transport: newTokenPool(base, s.githubTokenPool)}
+	}
+
+	httpClient := &http.Client{Transport: base}
+	if s.githubToken == "" {
+		return httpClient
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+	return oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{
+		AccessToken: s.githubToken,
+	}))
+}
+
+// githubClient builds the GitHub API client, pointed at the configured
+// GitHub Enterprise base URL when one is set
+func (s *Server) githubClient() (*github.Client, error) {
+	httpClient := s.githubHTTPClient()
+	if s.githubBaseURL == "" {
+		return github.NewClient(httpClient), nil
+	}
+
+	parsed, err := url.Parse(s.githubBaseURL)
+	if err != nil || !parsed.IsAbs() || parsed.Host == "" {
+		return nil, errors.Errorf("malformed GitHub base URL %q", s.githubBaseURL)
+	}
+
+	// NewEnterpriseClient appends the trailing slash go-github expects
+	// on both endpoints
+	client, err := github.NewEnterpriseClient(s.githubBaseURL, s.githubBaseURL, httpClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating GitHub Enterprise client")
+	}
+	return client, nil
+}
+
+// committerService builds the gRPC service implementation backed by the
+// given GitHub client, optionally decorated with the response cache.
+func (s *Server) committerService(client *github.Client) pb.CommitterServiceServer {
+	var repoGetter RepositoryGetter = client.Search
+	var contributorsGetter ContributorsGetter = client.Repositories
+	if s.backend == backendMock {
+		repoGetter, contributorsGetter = newMockBackendGetters()
+	} else if s.repoGetter == nil || s.contributorsGetter == nil {
+		// The rate limit endpoint is free to call and still verifies
+		// both the connectivity and the token
+		s.githubPing = func(ctx context.Context) error {
+			_, _, err := client.RateLimits(ctx)
+			return err
+		}
+	}
+	// Injected getters take precedence over the GitHub client ones, for
+	// integration tests that drive the full HTTP stack against fakes
+	if s.repoGetter != nil {
+		repoGetter = s.repoGetter
+	}
+	if s.contributorsGetter != nil {
+		contributorsGetter = s.contributorsGetter
+	}
+	if s.retryMaxAttempts > 1 {
+		policy := retryPolicy{
+			maxAttempts: s.retryMaxAttempts,
+			baseDelay:   s.retryBaseDelay,
+		}
+		repoGetter = retryingRepositoryGetter{delegate: repoGetter, policy: policy}
+		contributorsGetter = retryingContributorsGetter{delegate: contributorsGetter, policy: policy}
+	}
+
+	var service pb.CommitterServiceServer = &committerService{
+		logger:                 s.logger,
+		repoGetter:             repoGetter,
+		contributorsGetter:     contributorsGetter,
+		maxContributorPages:    s.maxContributorPages,
+		defaultMaxContributors: s.defaultMaxContributors,
+		topRepositories:        s.topRepositories,
+		includeAnonymous:       s.includeAnonymous,
+		upstreamTimeout:        s.upstreamTimeout,
+	}
+
+	if s.cacheTTL > 0 {
+		service = newCachedCommitterService(service, s.cacheTTL, s.defaultMaxContributors)
+	}
+
+	s.service = service
+	return service
+}
+
+// warmUp primes the response cache for the configured languages and
+// marks the server as ready once all of them were requested. Individual
+// failures are logged but do not block readiness forever.
+func (s *Server) warmUp(service pb.CommitterServiceServer) {
+	for _, lang := range s.warmupLanguages {
+		_, err := service.MostActiveCommitter(context.Background(), &pb.CommitterRequest{Language: lang})
+		if err != nil {
+			s.logger.Warn("Cache warm-up request failed",
+				zap.String("language", lang),
+				zap.Error(err))
+		}
+	}
+	s.setReady()
+}
+
+func (s *Server) setDraining() {
+	s.readyMu.Lock()
+	s.draining = true
+	s.readyMu.Unlock()
+}
+
+func (s *Server) isDraining() bool {
+	s.readyMu.RLock()
+	defer s.readyMu.RUnlock()
+	return s.draining
+}
+
+// drainingHandler rejects new requests with 503 and a Retry-After hint
+// once shutdown began. In-flight requests are not affected and keep
+// draining through the http.Server shutdown.
+func (s *Server) drainingHandler(next http.Handler) http.Handler {
+	retryAfter := "1"
+	if s.shutdownTimeout > 0 {
+		retryAfter = fmt.Sprintf("%.0f", s.shutdownTimeout.Seconds())
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if s.isDraining() {
+			w.Header().Set("Retry-After", retryAfter)
+			http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (s *Server) setReady() {
+	s.readyMu.Lock()
+	s.ready = true
+	s.readyMu.Unlock()
+}
+
+func (s *Server) isReady() bool {
+	s.readyMu.RLock()
+	defer s.readyMu.RUnlock()
+	return s.ready
+}
+
+// invalidateHandler evicts cache entries for the language given in the
+// request so operators can drop stale rankings after a known change.
+// The caller authenticates with the configured webhook secret.
+func (s *Server) invalidateHandler(w http.ResponseWriter, req *http.Request) {
+	if s.webhookSecret == "" {
+		http.Error(w, "the invalidation webhook is not configured", http.StatusNotFound)
+		return
+	}
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(req.Header.Get("X-Webhook-Token")), []byte(s.webhookSecret)) != 1 {
+		http.Error(w, "invalid webhook token", http.StatusUnauthorized)
+		return
+	}
+
+	cache, ok := s.service.(*cachedCommitterService)
+	if !ok {
+		http.Error(w, "the response cache is not enabled", http.StatusConflict)
+		return
+	}
+
+	language := req.FormValue("language")
+	if language == "" {
+		http.Error(w, "language needs to be provided", http.StatusBadRequest)
+		return
+	}
+
+	evicted := cache.InvalidateLanguage(language)
+	s.logger.Info("Cache invalidated by webhook",
+		zap.String("language", language),
+		zap.Int("evicted", evicted))
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"evicted":%d}`, evicted)
+}
+
+// readyzPingTimeout bounds the GitHub connectivity check of the
+// readiness probe so a hanging upstream does not hang the probe
+const readyzPingTimeout = 2 * time.Second
+
+// readyzHandler reports whether the server is ready to receive traffic.
+// Unlike /healthz it stays unavailable until the cache warm-up finished
+// and GitHub is reachable with the configured token.
+func (s *Server) readyzHandler(w http.ResponseWriter, req *http.Request) {
+	if !s.isReady() {
+		http.Error(w, "warming up", http.StatusServiceUnavailable)
+		return
+	}
+
+	if s.githubPing != nil {
+		ctx, cancel := context.WithTimeout(req.Context(), readyzPingTimeout)
+		defer cancel()
+		if err := s.githubPing(ctx); err != nil {
+			s.logger.Warn("Readiness GitHub check failed", zap.Error(err))
+			http.Error(w, "GitHub is not reachable", http.StatusServiceUnavailable)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) createHTTPServer() (*http.Server, error) {
+	addr := s.listener.Addr().String()
+
+	client, err := s.githubClient()
+	if err != nil {
+		return nil, err
+	}
+
+	service := s.committerService(client)
+
+	grpcServer := registerCommitterService(service, s.health, s.createGRPCOptions()...)
+	s.grpcSrv = grpcServer
+
+	if s.reflectionEnabled {
+		reflection.Register(grpcServer)
+	}
+	if !s.metricsDisabled {
+		grpc_prometheus.Register(grpcServer)
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithInsecure()}
+
+	mux, err := s.registerServerMux(addr, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHandler := &h2c.HandlerH2C{
+		Handler:  requestIDHandler(grpcHandlerFunc(grpcServer, mux)),
+		H2Server: &http2.Server{MaxConcurrentStreams: s.maxConcurrentStreams},
+	}
+
+	read, write, idle := s.httpTimeouts()
+	return &http.Server{
+		Addr:         addr,
+		Handler:      rootHandler,
+		ReadTimeout:  read,
+		WriteTimeout: write,
+		IdleTimeout:  idle,
+	}, nil
+}
+
+// httpTimeouts returns the connection timeouts for the HTTP server,
+// falling back to the defaults because unlimited timeouts are a
+// production hazard
+func (s *Server) httpTimeouts() (read, write, idle time.Duration) {
+	read, write, idle = defaultReadTimeout, defaultWriteTimeout, defaultIdleTimeout
+	if s.readTimeout > 0 {
+		read = s.readTimeout
+	}
+	if s.writeTimeout > 0 {
+		write = s.writeTimeout
+	}
+	if s.idleTimeout > 0 {
+		idle = s.idleTimeout
+	}
+	return read, write, idle
+}
+
+func (s *Server) createHTTPSServer() (*http.Server, error) {
+	addr := s.listener.Addr().String()
+
+	client, err := s.githubClient()
+	if err != nil {
+		return nil, err
+	}
+
+	service := s.committerService(client)
+
+	serverOpts := s.createGRPCOptions()
+
+	tlsCfg, err := s.serverTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	certPool, err := s.serverCertPool()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.secureCfg.clientCAFile != "" {
+		// Mutual TLS has to be enforced on the gRPC credentials as
+		// well, not only on the outer HTTP server
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	} else {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewClientTLSFromCert(certPool, addr)))
+	}
+
+	grpcServer := registerCommitterService(service, s.health, serverOpts...)
+	s.grpcSrv = grpcServer
+
+	if s.reflectionEnabled {
+		reflection.Register(grpcServer)
+	}
+	if !s.metricsDisabled {
+		grpc_prometheus.Register(grpcServer)
+	}
+
+	dialOpts := secureDialOpts(s.serverName, certPool)
+
+	mux, err := s.registerServerMux(addr, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHandler := requestIDHandler(grpcHandlerFunc(grpcServer, mux))
+
+	read, write, idle := s.httpTimeouts()
+	srv := &http.Server{
+		Addr:         addr,
+		Handler:      rootHandler,
+		TLSConfig:    tlsCfg,
+		ReadTimeout:  read,
+		WriteTimeout: write,
+		IdleTimeout:  idle,
+	}
+	if s.maxConcurrentStreams > 0 {
+		if err := http2.ConfigureServer(srv, &http2.Server{
+			MaxConcurrentStreams: s.maxConcurrentStreams,
+		}); err != nil {
+			return nil, errors.Wrap(err, "configuring HTTP/2")
+		}
+	}
+	return srv, nil
+}
+
+// serverTLSConfig builds the TLS configuration the server presents.
+// The keypair comes from the configured PEM bytes when provided, from
+// the certificate files otherwise. Client certificates are required
+// and verified when a client CA is configured, one way TLS is used
+// otherwise.
+func (s *Server) serverTLSConfig() (*tls.Config, error) {
+	if len(s.secureCfg.certPEM) > 0 || len(s.secureCfg.keyPEM) > 0 {
+		tlsCfg, err := certs.CreateTLSConfigFromPEM(s.secureCfg.certPEM, s.secureCfg.keyPEM)
+		if err != nil {
+			return nil, err
+		}
+		if s.secureCfg.clientCAFile != "" {
+			clientCAs, err := certs.CreatePool(s.secureCfg.clientCAFile)
+			if err != nil {
+				return nil, errors.Wrap(err, "loading client CA")
+			}
+			tlsCfg.ClientCAs = clientCAs
+			tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		return tlsCfg, nil
+	}
+
+	if s.secureCfg.clientCAFile != "" {
+		return certs.CreateMutualTLSConfig(
+			s.secureCfg.certFile, s.secureCfg.keyFile, s.secureCfg.clientCAFile)
+	}
+	return certs.CreateTLSConfig(s.secureCfg.certFile, s.secureCfg.keyFile)
+}
+
+func registerCommitterService(s pb.CommitterServiceServer, healthSrv *health.Server,
+	serverOpts ...grpc.ServerOption) *grpc.Server {
+
+	grpcServer := grpc.NewServer(serverOpts...)
+
+	pb.RegisterCommitterServiceServer(grpcServer, s)
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthSrv)
+
+	return grpcServer
+}
+
+func (s *Server) createGRPCOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if s.maxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(s.maxConcurrentStreams))
+	}
+
+	if s.maxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(s.maxRecvMsgSize))
+	}
+	if s.maxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(s.maxSendMsgSize))
+	}
+
+	if s.keepaliveTime > 0 {
+		opts = append(opts,
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				Time:    s.keepaliveTime,
+				Timeout: s.keepaliveTimeout,
+			}),
+			// Clients may ping at half the server interval without
+			// being throttled. Anything tighter would answer their
+			// pings with ENHANCE_YOUR_CALM and close the connection
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             s.keepaliveTime / 2,
+				PermitWithoutStream: true,
+			}))
+	}
+
+	grpc_zap.ReplaceGrpcLogger(zap.L())
+
+	optZap := []grpc_zap.Option{
+		// Add filed to logs that comes from gRPC middleware
+		grpc_zap.WithDurationField(func(duration time.Duration) zapcore.Field {
+			return zap.Int64("grpc.time_ns", duration.Nanoseconds())
+		}),
+	}
+
+	zap.L().Debug("The rate limiting configuration",
+		zap.Int64("capacity", s.capacity),
+		zap.Int64("Rate per second", s.rate),
+		zap.Bool("global", s.globalRateLimit),
+	)
+
+	s.rateMu.Lock()
+	s.rateLimiter = &reloadableLimiter{}
+	s.rateLimiter.store(s.newRateLimiter())
+	s.rateMu.Unlock()
+
+	rateOpts := []grpc_ratelimit.Option{
+		grpc_ratelimit.WithLimiter(s.rateLimiter),
+		grpc_ratelimit.WithMaxWaitDuration(time.Microsecond), // Almost no wait for bucket to be filled
+		grpc_ratelimit.WithCostFunc(s.requestSizeCost),
+	}
+	if !s.globalRateLimit {
+		// Every client gets its own bucket so one aggressive client
+		// cannot starve the others
+		rateOpts = append(rateOpts, grpc_ratelimit.WithKeyFunc(peerAddressKey))
+	}
+
+	var interceptors []grpc.UnaryServerInterceptor
+	if !s.tracingDisabled {
+		interceptors = append(interceptors, grpc_opentracing.UnaryServerInterceptor())
+	}
+	interceptors = append(interceptors, grpc_ratelimit.UnaryServerInterceptor(rateOpts...))
+	if !s.metricsDisabled {
+		// The handling time histogram powers latency SLO dashboards
+		buckets := s.latencyBuckets
+		if len(buckets) == 0 {
+			buckets = defaultLatencyBuckets
+		}
+		grpc_prometheus.EnableHandlingTimeHistogram(grpc_prometheus.WithHistogramBuckets(buckets))
+		interceptors = append(interceptors, grpc_prometheus.UnaryServerInterceptor)
+	}
+	interceptors = append(interceptors,
+		grpc_zap.UnaryServerInterceptor(zap.L(), optZap...),
+		requestIDUnaryInterceptor(),
+		grpc_recovery.UnaryServerInterceptor(
+			grpc_recovery.WithRecoveryHandler(recoveredPanicHandler)),
+	)
+
+	if len(s.tlsOnlyMethods) > 0 {
+		interceptors = append([]grpc.UnaryServerInterceptor{
+			requireTLSUnaryInterceptor(s.tlsOnlyMethods),
+		}, interceptors...)
+	}
+
+	if s.contextDecorator != nil {
+		interceptors = append([]grpc.UnaryServerInterceptor{
+			contextDecoratorUnaryInterceptor(s.contextDecorator),
+		}, interceptors...)
+	}
+
+	if s.requestTimeout > 0 {
+		// First in the chain so the deadline bounds everything that
+		// runs on behalf of the request, interceptors included
+		interceptors = append([]grpc.UnaryServerInterceptor{
+			requestTimeoutUnaryInterceptor(s.requestTimeout),
+		}, interceptors...)
+	}
+
+	// The streaming RPC has to pass the same gates as the unary ones,
+	// otherwise switching to the stream would dodge the rate limiter and
+	// a handler panic would crash the process, since grpc-go does not
+	// recover stream handlers
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if !s.tracingDisabled {
+		streamInterceptors = append(streamInterceptors, grpc_opentracing.StreamServerInterceptor())
+	}
+	streamInterceptors = append(streamInterceptors, grpc_ratelimit.StreamServerInterceptor(rateOpts...))
+	if !s.metricsDisabled {
+		streamInterceptors = append(streamInterceptors, grpc_prometheus.StreamServerInterceptor)
+	}
+	streamInterceptors = append(streamInterceptors,
+		grpc_zap.StreamServerInterceptor(zap.L(), optZap...),
+		requestIDStreamInterceptor(),
+		grpc_recovery.StreamServerInterceptor(
+			grpc_recovery.WithRecoveryHandler(recoveredPanicHandler)),
+	)
+
+	if len(s.tlsOnlyMethods) > 0 {
+		streamInterceptors = append([]grpc.StreamServerInterceptor{
+			requireTLSStreamInterceptor(s.tlsOnlyMethods),
+		}, streamInterceptors...)
+	}
+
+	opts = append(opts,
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(interceptors...)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)))
+
+	return opts
+}
+
+// recoveredPanicHandler makes recovered handler panics visible. Every
+// panic is logged with its stack and counted, so operators can alert on
+// panicking handlers instead of only seeing Internal errors
+func recoveredPanicHandler(p interface{}) error {
+	grpcPanics.Inc()
+	zap.L().Error("Recovered panic in gRPC handler",
+		zap.Any("panic", p),
+		zap.ByteString("stack", debug.Stack()))
+	return status.Error(codes.Internal, "Internal server error")
+}
+
+// requestTimeoutUnaryInterceptor bounds the total handler time of every
+// unary call, so a slow GitHub backend cannot tie up a connection
+// indefinitely. Calls that exceed the deadline fail with
+// codes.DeadlineExceeded
+func requestTimeoutUnaryInterceptor(d time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+
+		resp, err := handler(ctx, req)
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, status.Errorf(codes.DeadlineExceeded,
+				"Request did not finish within the %s server deadline", d)
+		}
+		return resp, err
+	}
+}
+
+// contextDecoratorUnaryInterceptor lets embedders seed every request
+// context, for example with trace baggage or feature flags, before any
+// other interceptor or the handler runs
+func contextDecoratorUnaryInterceptor(decorate func(context.Context) context.Context) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		return handler(decorate(ctx), req)
+	}
+}
+
+// requireTLSUnaryInterceptor rejects cleartext calls to the given methods.
+// It protects sensitive methods when the server is reachable over both
+// h2c and TLS, for example behind a TLS terminating proxy.
+func requireTLSUnaryInterceptor(methods map[string]bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if !methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		p, ok := peer.FromContext(ctx)
+		if !ok || p.AuthInfo == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "%s requires a TLS connection", info.FullMethod)
+		}
+		if _, isTLS := p.AuthInfo.(credentials.TLSInfo); !isTLS {
+			return nil, status.Errorf(codes.PermissionDenied, "%s requires a TLS connection", info.FullMethod)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// requireTLSStreamInterceptor is the streaming counterpart of
+// requireTLSUnaryInterceptor
+func requireTLSStreamInterceptor(methods map[string]bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		if !methods[info.FullMethod] {
+			return handler(srv, stream)
+		}
+
+		p, ok := peer.FromContext(stream.Context())
+		if !ok || p.AuthInfo == nil {
+			return status.Errorf(codes.PermissionDenied, "%s requires a TLS connection", info.FullMethod)
+		}
+		if _, isTLS := p.AuthInfo.(credentials.TLSInfo); !isTLS {
+			return status.Errorf(codes.PermissionDenied, "%s requires a TLS connection", info.FullMethod)
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// newRateLimiter builds the configured limiter flavor from the current
+// rate and capacity. A non-positive rate would panic on the fill
+// interval division and a non-positive capacity panics inside the
+// token bucket, so both are clamped to the smallest working limiter.
+func (s *Server) newRateLimiter() grpc_ratelimit.Limiter {
+	rate, capacity := s.rate, s.capacity
+	if rate < 1 || capacity < 1 {
+		s.logger.Warn("Non-positive rate limit configuration, clamping to 1",
+			zap.Int64("rate", rate),
+			zap.Int64("capacity", capacity))
+		if rate < 1 {
+			rate = 1
+		}
+		if capacity < 1 {
+			capacity = 1
+		}
+	}
+
+	if s.rateLimitAlgo == AlgorithmSlidingWindow {
+		// The sliding window admits at most rate requests within any
+		// rolling second, so the capacity burst allowance does not apply
+		if s.globalRateLimit {
+			return slidingwindow.NewSlidingWindowRateLimiter(time.Second, rate)
+		}
+		return slidingwindow.NewKeyedSlidingWindowRateLimiter(
+			time.Second, rate, rateLimiterIdleTimeout)
+	}
+
+	if s.globalRateLimit {
+		return tokenbucket.NewTokenBucketRateLimiter(
+			time.Second/time.Duration(rate), capacity, 1)
+	}
+	return tokenbucket.NewKeyedTokenBucketRateLimiter(
+		time.Second/time.Duration(rate), capacity, 1, rateLimiterIdleTimeout)
+}
+
+// SetRateLimit reconfigures the rate limiter at runtime so operators
+// can tune the rate and capacity without restarting the server. The
+// buckets start fresh with the new parameters on the next request.
+func (s *Server) SetRateLimit(rate, capacity int64) error {
+	if rate <= 0 || capacity <= 0 {
+		return errors.New("rate and capacity need to be bigger than zero")
+	}
+
+	s.rateMu.Lock()
+	defer s.rateMu.Unlock()
+
+	s.rate = rate
+	s.capacity = capacity
+	if s.rateLimiter != nil {
+		s.rateLimiter.store(s.newRateLimiter())
+	}
+
+	s.logger.Info("Rate limit reconfigured",
+		zap.Int64("rate", rate),
+		zap.Int64("capacity", capacity))
+	return nil
+}
+
+// ratelimitHandler reports the current limiter state on GET and applies
+// new rate limiter parameters given in the rate and capacity form
+// values on POST. When a webhook secret is configured the caller
+// authenticates with the same X-Webhook-Token header as the
+// invalidation webhook.
+func (s *Server) ratelimitHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodGet {
+		s.ratelimitStateHandler(w)
+		return
+	}
+	if req.Method != http.MethodPost {
+		http.Error(w, "only GET and POST are allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.webhookSecret != "" &&
+		subtle.ConstantTimeCompare([]byte(req.Header.Get("X-Webhook-Token")), []byte(s.webhookSecret)) != 1 {
+		http.Error(w, "invalid webhook token", http.StatusUnauthorized)
+		return
+	}
+
+	rate, err := strconv.ParseInt(req.FormValue("rate"), 10, 64)
+	if err != nil {
+		http.Error(w, "rate needs to be a number", http.StatusBadRequest)
+		return
+	}
+	capacity, err := strconv.ParseInt(req.FormValue("capacity"), 10, 64)
+	if err != nil {
+		http.Error(w, "capacity needs to be a number", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.SetRateLimit(rate, capacity); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"rate":%d,"capacity":%d}`, rate, capacity)
+}
+
+// ratelimitStateHandler reports how full the token bucket is, so
+// operators can tell whether throttling explains ResourceExhausted
+// errors seen by clients
+func (s *Server) ratelimitStateHandler(w http.ResponseWriter) {
+	s.rateMu.Lock()
+	limiter := s.rateLimiter
+	s.rateMu.Unlock()
+
+	if limiter == nil {
+		http.Error(w, "the rate limiter is not running", http.StatusServiceUnavailable)
+		return
+	}
+	reporter, ok := limiter.load().(grpc_ratelimit.StateReporter)
+	if !ok {
+		http.Error(w, "the configured limiter does not report its state", http.StatusNotImplemented)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(reporter.LimiterState()); err != nil {
+		s.logger.Error("Writing the rate limiter state failed", zap.Error(err))
+	}
+}
+
+// peerAddressKey partitions the rate limiter budget by the client IP of
+// the calling peer. Calls without peer information, such as in-process
+// ones, share the bucket of the empty key.
+func peerAddressKey(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// requestSizeCost makes expensive queries consume a bigger part of the
+// rate limiter budget than small ones. Every started batch of
+// maxContributors requested contributors costs one token. A batch
+// request costs the sum of its entries, so packing queries into a batch
+// does not dodge the limiter.
+func (s *Server) requestSizeCost(req interface{}) int64 {
+	switch r := req.(type) {
+	case *pb.CommitterRequest:
+		return int64((requestedContributors(r, s.defaultMaxContributors) + maxContributors - 1) / maxContributors)
+	case *pb.BatchCommitterRequest:
+		var cost int64
+		for _, entry := range r.Requests {
+			cost += s.requestSizeCost(entry)
+		}
+		if cost < 1 {
+			cost = 1
+		}
+		return cost
+	}
+	return 1
+}
+
+// CreateSecureDialOpts builds the TLS dial options used to reach the
+// gRPC endpoint. The gateway dials the local endpoint with them and
+// external clients, such as the client subcommand, can reuse them to
+// dial a remote server the same way.
+func CreateSecureDialOpts(serverOverrideName string, certFile string) ([]grpc.DialOption, error) {
+	certPool, err := certs.CreatePool(certFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "crating certificate pool")
+	}
+	return secureDialOpts(serverOverrideName, certPool), nil
+}
+
+// secureDialOpts builds TLS dial options trusting the given pool
+func secureDialOpts(serverOverrideName string, certPool *x509.CertPool) []grpc.DialOption {
+	tlsCfg := &tls.Config{RootCAs: certPool}
+	// The name is matched against the certificate Subject Alternative
+	// Names, so any host the certificate was generated for works, not
+	// only localhost. When empty, the host of the dialed address is
+	// verified instead.
+	if serverOverrideName != "" {
+		tlsCfg.ServerName = serverOverrideName
+	}
+	tCreds := credentials.NewTLS(tlsCfg)
+	return []grpc.DialOption{grpc.WithTransportCredentials(tCreds)}
+}
+
+// serverCertPool builds the pool holding the server own certificate,
+// either from the configured PEM bytes or from the certificate file
+func (s *Server) serverCertPool() (*x509.CertPool, error) {
+	if len(s.secureCfg.certPEM) > 0 {
+		return certs.CreatePoolFromPEM(s.secureCfg.certPEM)
+	}
+	return certs.CreatePool(s.secureCfg.certFile)
+}
+
+// swaggerForRequest rewrites the embedded swagger document so its host
+// and scheme point at the server that actually served it. Without it
+// the "Try it out" button of the swagger UI targets the wrong place
+func (s *Server) swaggerForRequest(host string) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(pb.Swagger), &doc); err != nil {
+		return nil, errors.Wrap(err, "decoding the embedded swagger document")
+	}
+
+	doc["host"] = host
+	scheme := "http"
+	if s.secureCfg.secure {
+		scheme = "https"
+	}
+	doc["schemes"] = []string{scheme}
+
+	return json.Marshal(doc)
+}
+
+// registerServerMux is helper function that registers many http1.1 endpoints in mux.
+// The returned handler wraps the mux in an access log so the REST path
+// is as observable as the gRPC one.
+func (s *Server) registerServerMux(addr string, dialOpts ...grpc.DialOption) (http.Handler, error) {
+	dialOpts = s.gatewayDialOpts(dialOpts)
+
+	mux := http.NewServeMux()
+	mux.Handle("/swagger.json", gzipHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		doc, err := s.swaggerForRequest(req.Host)
+		if err != nil {
+			zap.L().Error("Rewriting the swagger document failed", zap.Error(err))
+			http.Error(w, "swagger.json is currently unavailable", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(doc); err != nil {
+			zap.L().Error("Writing the swagger document failed", zap.Error(err))
+		}
+	})))
+
+	gwmux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayJSONMarshaler()),
+		runtime.WithIncomingHeaderMatcher(requestIDHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
+	ctx := context.Background()
+	err := pb.RegisterCommitterServiceHandlerFromEndpoint(ctx, gwmux, addr, dialOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register gRPC gateway: %v", err)
+	}
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/readyz", s.readyzHandler)
+	mux.HandleFunc("/webhook/invalidate", s.invalidateHandler)
+	mux.HandleFunc("/admin/ratelimit", s.ratelimitHandler)
+
+	conn, err := grpc.Dial(addr, dialOpts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "dialing the streaming endpoint")
+	}
+	mux.HandleFunc("/v1alpha1/committer/stream", streamCommitterHandler(pb.NewCommitterServiceClient(conn)))
+
+	if !s.metricsDisabled {
+		mux.Handle("/metrics", promhttp.Handler())
+	}
+	mux.Handle("/", gzipHandler(s.drainingHandler(envelopeHandler(s.serverName, gwmux))))
+	ui.ServeSwagger(mux)
+
+	return accessLogHandler(s.logger, mux), nil
+}
+
+// gatewayDialOpts adapts the gateway dial options to the listener
+// network. A unix listener reports the bare socket path as its address,
+// which grpc would otherwise dial as a TCP target, leaving every REST
+// route dead in socket mode.
+func (s *Server) gatewayDialOpts(dialOpts []grpc.DialOption) []grpc.DialOption {
+	if s.listener.Addr().Network() != "unix" {
+		return dialOpts
+	}
+	return append(dialOpts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", addr)
+	}))
+}
+
+// outgoingHeaderMatcher translates the rate limiter retry hint into
+// the standard Retry-After HTTP header. Every other metadata key keeps
+// the default Grpc-Metadata- prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == grpc_ratelimit.RetryAfterKey {
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// gatewayJSONMarshaler keeps the gateway JSON output stable so responses
+// can be hashed, signed or compared byte by byte. Fields are emitted in
+// the proto declaration order with their original names, including the
+// unpopulated ones.
+func gatewayJSONMarshaler() *runtime.JSONPb {
+	return &runtime.JSONPb{
+		OrigName:     true,
+		EmitDefaults: true,
+	}
+}
+
+// grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
+// connections or otherHandler otherwise. Copied from cockroachdb.
+func grpcHandlerFunc(grpcServer, otherHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// TODO(tamird): point to merged gRPC code rather than a PR.
+		// This is a partial recreation of gRPC's internal checks
+		// https://github.com/grpc/grpc-go/pull/514/files#diff-95e9a25b738459a2d3030e1e6fa2a718R61
+		if r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcServer.ServeHTTP(w, r)
+		} else {
+			otherHandler.ServeHTTP(w, r)
+		}
+	})
+}
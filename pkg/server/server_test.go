@@ -0,0 +1,777 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+	grpc_ratelimit "github.com/RafalKorepta/most-popular-committer/pkg/ratelimit"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type mockListener struct {
+	mock.Mock
+}
+
+func (l *mockListener) Accept() (net.Conn, error) {
+	return nil, nil
+}
+
+func (l *mockListener) Close() error {
+	return nil
+}
+
+func (l *mockListener) Addr() net.Addr {
+	return nil
+}
+
+func TestNewServer(t *testing.T) {
+	t.Run("Fails without a listener", func(t *testing.T) {
+		// Given no network listener
+
+		// When creating new server
+		_, err := NewServer(nil)
+
+		// Then an error is returned
+		assert.Error(t, err)
+	})
+
+	t.Run("Valid new server", func(t *testing.T) {
+		// Given network listener
+		mockListener := &mockListener{}
+
+		// When creating new server
+		srv, err := NewServer(mockListener)
+
+		// Then an error is returned
+		assert.NoError(t, err)
+		assert.Equal(t, mockListener, srv.listener)
+		assert.Equal(t, "most-popular-committer", srv.serverName)
+	})
+
+	t.Run("New server reports a serving health status", func(t *testing.T) {
+		// Given new server
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+
+		// When the health service is probed
+		resp, err := srv.health.Check(context.TODO(), &grpc_health_v1.HealthCheckRequest{})
+
+		// Then the server reports SERVING
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+	})
+
+	t.Run("Shutdown flips the health status to NOT_SERVING", func(t *testing.T) {
+		// Given new server
+		srv, err := NewServer(&mockListener{}, WithShutdownTimeout(time.Second))
+		require.NoError(t, err)
+
+		// When the server is shut down
+		require.NoError(t, srv.Shutdown(context.TODO()))
+
+		// Then the health service reports NOT_SERVING
+		resp, err := srv.health.Check(context.TODO(), &grpc_health_v1.HealthCheckRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+	})
+
+	t.Run("HTTP timeouts default to the slowloris guards", func(t *testing.T) {
+		// Given new server without timeout options
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+
+		// When the HTTP timeouts are resolved
+		read, write, idle := srv.httpTimeouts()
+
+		// Then the defaults apply instead of unlimited timeouts
+		assert.Equal(t, defaultReadTimeout, read)
+		assert.Equal(t, defaultWriteTimeout, write)
+		assert.Equal(t, defaultIdleTimeout, idle)
+	})
+
+	t.Run("HTTP timeouts can be overridden", func(t *testing.T) {
+		// Given new server with custom timeouts
+		srv, err := NewServer(&mockListener{},
+			WithHTTPTimeouts(time.Second, 2*time.Second, 3*time.Second))
+		require.NoError(t, err)
+
+		// When the HTTP timeouts are resolved
+		read, write, idle := srv.httpTimeouts()
+
+		// Then the configured values are used
+		assert.Equal(t, time.Second, read)
+		assert.Equal(t, 2*time.Second, write)
+		assert.Equal(t, 3*time.Second, idle)
+	})
+
+	t.Run("Valid new server with all functional options", func(t *testing.T) {
+		// Given network listener
+		mockListener := &mockListener{}
+
+		// When creating new server
+		srv, err := NewServer(mockListener,
+			WithServerName("backend"),
+			WithLogger(zap.L()),
+			WithCertFile("../certs/local_certs/server.pem"),
+			WithKeyFile("../certs/local_certs/server.key"),
+			WithSecure(true),
+			WithCapacity(10),
+			WithRate(25),
+			WithMaxContributorPages(3),
+			WithGitHubToken("token"),
+			WithCache(time.Minute),
+			WithDefaultMaxContributors(50),
+			WithDefaultTopRepositories(10),
+			WithShutdownTimeout(time.Second),
+		)
+
+		// Then an error is returned
+		assert.NoError(t, err)
+		assert.Equal(t, mockListener, srv.listener)
+		assert.Equal(t, "backend", srv.serverName)
+	})
+}
+
+func TestRequireTLSUnaryInterceptor(t *testing.T) {
+	const protectedMethod = "/committer.v1alpha1.CommitterService/MostActiveCommitter"
+
+	interceptor := requireTLSUnaryInterceptor(map[string]bool{
+		protectedMethod: true,
+	})
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "response", nil
+	}
+
+	t.Run("Reject cleartext call to a protected method", func(t *testing.T) {
+		// Given cleartext peer
+		ctx := peer.NewContext(context.TODO(), &peer.Peer{})
+
+		// When the protected method is intercepted
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: protectedMethod}, handler)
+
+		// Then the call is rejected
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Allow TLS call to a protected method", func(t *testing.T) {
+		// Given TLS peer
+		ctx := peer.NewContext(context.TODO(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{},
+		})
+
+		// When the protected method is intercepted
+		resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: protectedMethod}, handler)
+
+		// Then the call is passed through
+		require.NoError(t, err)
+		assert.Equal(t, "response", resp)
+	})
+
+	t.Run("Allow cleartext call to an unprotected method", func(t *testing.T) {
+		// Given cleartext peer
+		ctx := peer.NewContext(context.TODO(), &peer.Peer{})
+
+		// When an unprotected method is intercepted
+		resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/other/Method"}, handler)
+
+		// Then the call is passed through
+		require.NoError(t, err)
+		assert.Equal(t, "response", resp)
+	})
+}
+
+func TestContextDecoratorUnaryInterceptor(t *testing.T) {
+	type ctxKey struct{}
+
+	t.Run("Injected value is visible inside the handler", func(t *testing.T) {
+		// Given an interceptor with a decorator that injects a value
+		interceptor := contextDecoratorUnaryInterceptor(func(ctx context.Context) context.Context {
+			return context.WithValue(ctx, ctxKey{}, "injected")
+		})
+
+		// And a handler that reads the value back
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return ctx.Value(ctxKey{}), nil
+		}
+
+		// When a call is intercepted
+		resp, err := interceptor(context.TODO(), nil, &grpc.UnaryServerInfo{}, handler)
+
+		// Then the handler observed the injected value
+		require.NoError(t, err)
+		assert.Equal(t, "injected", resp)
+	})
+}
+
+func TestRecoveredPanicHandler(t *testing.T) {
+	t.Run("Recovered panic is counted and converted to Internal", func(t *testing.T) {
+		// Given the panic counter before the recovery
+		before := testutil.ToFloat64(grpcPanics)
+
+		// When a recovered panic is handled
+		err := recoveredPanicHandler("boom")
+
+		// Then the caller sees an Internal error and the panic counts
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, before+1, testutil.ToFloat64(grpcPanics))
+	})
+}
+
+func TestRequestTimeoutUnaryInterceptor(t *testing.T) {
+	t.Run("Slow handler fails with the deadline exceeded", func(t *testing.T) {
+		// Given an interceptor with a very short deadline
+		interceptor := requestTimeoutUnaryInterceptor(time.Millisecond)
+
+		// And a handler that outlives it
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			<-ctx.Done()
+			return "late response", nil
+		}
+
+		// When a call is intercepted
+		_, err := interceptor(context.TODO(), nil, &grpc.UnaryServerInfo{}, handler)
+
+		// Then the call fails with the deadline exceeded
+		require.Error(t, err)
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+
+	t.Run("Fast handler passes through", func(t *testing.T) {
+		// Given an interceptor with a generous deadline
+		interceptor := requestTimeoutUnaryInterceptor(time.Minute)
+
+		// And a handler that observes the injected deadline
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, ok := ctx.Deadline()
+			return ok, nil
+		}
+
+		// When a call is intercepted
+		resp, err := interceptor(context.TODO(), nil, &grpc.UnaryServerInfo{}, handler)
+
+		// Then the handler ran under a deadline and its response is kept
+		require.NoError(t, err)
+		assert.Equal(t, true, resp)
+	})
+}
+
+func TestGatewayJSONMarshaler(t *testing.T) {
+	resp := &pb.CommitterResponse{
+		Language: "go",
+		Contributors: []*pb.Committer{
+			{
+				Name:    "test user",
+				Commits: 1,
+			},
+		},
+	}
+
+	t.Run("Identical responses marshal to identical bytes", func(t *testing.T) {
+		// Given the gateway marshaler
+		m := gatewayJSONMarshaler()
+
+		// When the same response is marshaled twice
+		first, err := m.Marshal(resp)
+		assert.NoError(t, err)
+		second, err := m.Marshal(resp)
+		assert.NoError(t, err)
+
+		// Then the output is byte identical
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("Unpopulated fields are emitted", func(t *testing.T) {
+		// Given the gateway marshaler
+		m := gatewayJSONMarshaler()
+
+		// When an empty response is marshaled
+		out, err := m.Marshal(&pb.CommitterResponse{})
+		assert.NoError(t, err)
+
+		// Then all fields are present
+		assert.JSONEq(t, `{"language":"","contributors":[],"results":[],"total_count":0,"next_page":0,`+
+			`"total_repositories":"0","incomplete_results":false}`, string(out))
+	})
+}
+
+func TestInvalidateWebhook(t *testing.T) {
+	req := &pb.CommitterRequest{
+		Language: "go",
+	}
+
+	newServerWithCache := func(t *testing.T) (*Server, *countingCommitterService) {
+		srv, err := NewServer(&mockListener{}, WithWebhookSecret("s3cret"))
+		require.NoError(t, err)
+		delegate := &countingCommitterService{}
+		srv.service = newCachedCommitterService(delegate, time.Minute, 0)
+		return srv, delegate
+	}
+
+	t.Run("Posted invalidation makes the next request recompute", func(t *testing.T) {
+		// Given server with a warmed cache entry
+		srv, delegate := newServerWithCache(t)
+		_, err := srv.service.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+		_, err = srv.service.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+		require.Equal(t, 1, delegate.calls)
+
+		// When the invalidation webhook is posted
+		webhookReq := httptest.NewRequest(http.MethodPost, "/webhook/invalidate?language=go", nil)
+		webhookReq.Header.Set("X-Webhook-Token", "s3cret")
+		rec := httptest.NewRecorder()
+		srv.invalidateHandler(rec, webhookReq)
+
+		// Then the entry is evicted and the next request recomputes
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"evicted":1}`, rec.Body.String())
+
+		_, err = srv.service.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+		assert.Equal(t, 2, delegate.calls)
+	})
+
+	t.Run("Invalid token is rejected", func(t *testing.T) {
+		// Given server with a warmed cache entry
+		srv, delegate := newServerWithCache(t)
+		_, err := srv.service.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+
+		// When the webhook is posted with the wrong token
+		webhookReq := httptest.NewRequest(http.MethodPost, "/webhook/invalidate?language=go", nil)
+		webhookReq.Header.Set("X-Webhook-Token", "wrong")
+		rec := httptest.NewRecorder()
+		srv.invalidateHandler(rec, webhookReq)
+
+		// Then the call is rejected and the cache entry survives
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		_, err = srv.service.MostActiveCommitter(context.TODO(), req)
+		require.NoError(t, err)
+		assert.Equal(t, 1, delegate.calls)
+	})
+
+	t.Run("Webhook without a configured secret stays disabled", func(t *testing.T) {
+		// Given server without a webhook secret
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+
+		// When the webhook is posted
+		rec := httptest.NewRecorder()
+		srv.invalidateHandler(rec, httptest.NewRequest(http.MethodPost, "/webhook/invalidate?language=go", nil))
+
+		// Then the endpoint reports not found
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestRateLimitAdminEndpoint(t *testing.T) {
+	t.Run("Posted parameters swap the running limiter", func(t *testing.T) {
+		// Given server with an exhausted single-token limiter
+		srv, err := NewServer(&mockListener{}, WithRate(1), WithCapacity(1))
+		require.NoError(t, err)
+		srv.rateLimiter = &reloadableLimiter{}
+		srv.rateLimiter.store(srv.newRateLimiter())
+		require.True(t, srv.rateLimiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+		require.False(t, srv.rateLimiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+
+		// When new parameters are posted
+		rec := httptest.NewRecorder()
+		srv.ratelimitHandler(rec, httptest.NewRequest(http.MethodPost,
+			"/admin/ratelimit?rate=100&capacity=5", nil))
+
+		// Then the limiter serves the fresh budget
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"rate":100,"capacity":5}`, rec.Body.String())
+		assert.EqualValues(t, 100, srv.rate)
+		assert.EqualValues(t, 5, srv.capacity)
+		assert.True(t, srv.rateLimiter.WaitKeyMaxDuration("10.0.0.1", 1, 0))
+	})
+
+	t.Run("GET reports the limiter state", func(t *testing.T) {
+		// Given server whose limiter budget was partially used
+		srv, err := NewServer(&mockListener{}, WithRate(1), WithCapacity(5))
+		require.NoError(t, err)
+		srv.rateLimiter = &reloadableLimiter{}
+		srv.rateLimiter.store(srv.newRateLimiter())
+		require.True(t, srv.rateLimiter.WaitKeyMaxDuration("10.0.0.1", 2, 0))
+		for i := 0; i < 10; i++ {
+			srv.rateLimiter.WaitKeyMaxDuration("10.0.0.1", 5, 0)
+		}
+
+		// When the state is requested
+		rec := httptest.NewRecorder()
+		srv.ratelimitHandler(rec, httptest.NewRequest(http.MethodGet, "/admin/ratelimit", nil))
+
+		// Then the remaining tokens and the rejects are reported
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var state struct {
+			Available int64 `json:"available"`
+			Capacity  int64 `json:"capacity"`
+			Buckets   int   `json:"buckets"`
+			Rejects   int64 `json:"rejects"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &state))
+		assert.EqualValues(t, 3, state.Available)
+		assert.EqualValues(t, 5, state.Capacity)
+		assert.Equal(t, 1, state.Buckets)
+		assert.EqualValues(t, 10, state.Rejects)
+	})
+
+	t.Run("Non positive parameters are rejected", func(t *testing.T) {
+		// Given a running server
+		srv, err := NewServer(&mockListener{}, WithRate(1), WithCapacity(1))
+		require.NoError(t, err)
+
+		// When a zero rate is posted
+		rec := httptest.NewRecorder()
+		srv.ratelimitHandler(rec, httptest.NewRequest(http.MethodPost,
+			"/admin/ratelimit?rate=0&capacity=5", nil))
+
+		// Then the parameters are rejected and stay unchanged
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.EqualValues(t, 1, srv.rate)
+	})
+
+	t.Run("Configured webhook secret protects the endpoint", func(t *testing.T) {
+		// Given a server with a webhook secret
+		srv, err := NewServer(&mockListener{}, WithRate(1), WithCapacity(1),
+			WithWebhookSecret("s3cret"))
+		require.NoError(t, err)
+
+		// When parameters are posted without the token
+		rec := httptest.NewRecorder()
+		srv.ratelimitHandler(rec, httptest.NewRequest(http.MethodPost,
+			"/admin/ratelimit?rate=100&capacity=5", nil))
+
+		// Then the call is rejected
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.EqualValues(t, 1, srv.rate)
+	})
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Run("A zero rate does not panic at startup", func(t *testing.T) {
+		// Given a server configured without rate and capacity
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+
+		// When the limiter is built
+		var limiter grpc_ratelimit.Limiter
+		require.NotPanics(t, func() {
+			limiter = srv.newRateLimiter()
+		})
+
+		// Then it serves the smallest working budget
+		assert.True(t, limiter.WaitMaxDuration(0))
+	})
+
+	t.Run("A negative global rate does not panic at startup", func(t *testing.T) {
+		// Given a server configured with a negative rate
+		srv, err := NewServer(&mockListener{}, WithRate(-5), WithGlobalRateLimit())
+		require.NoError(t, err)
+
+		// When the limiter is built
+		var limiter grpc_ratelimit.Limiter
+		require.NotPanics(t, func() {
+			limiter = srv.newRateLimiter()
+		})
+
+		// Then it serves the smallest working budget
+		assert.True(t, limiter.WaitMaxDuration(0))
+	})
+}
+
+func TestDrainingHandler(t *testing.T) {
+	t.Run("New REST requests get 503 with Retry-After during shutdown", func(t *testing.T) {
+		// Given running server with a wrapped gateway handler
+		srv, err := NewServer(&mockListener{}, WithShutdownTimeout(5*time.Second))
+		require.NoError(t, err)
+		handler := srv.drainingHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		// Then requests pass through before shutdown
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1alpha1/committer", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		// When shutdown is triggered
+		require.NoError(t, srv.Shutdown(context.TODO()))
+
+		// Then new requests are rejected with 503 and a Retry-After hint
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1alpha1/committer", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Equal(t, "5", rec.Header().Get("Retry-After"))
+	})
+}
+
+type blockingCommitterService struct {
+	pb.CommitterServiceServer
+	release   chan struct{}
+	requested []string
+}
+
+func (b *blockingCommitterService) MostActiveCommitter(ctx context.Context,
+	req *pb.CommitterRequest) (*pb.CommitterResponse, error) {
+	<-b.release
+	b.requested = append(b.requested, req.Language)
+	return &pb.CommitterResponse{}, nil
+}
+
+func TestReadinessGate(t *testing.T) {
+	probeReadyz := func(srv *Server) int {
+		rec := httptest.NewRecorder()
+		srv.readyzHandler(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		return rec.Code
+	}
+
+	t.Run("Ready only after the warm-up finishes", func(t *testing.T) {
+		// Given server with configured warm-up languages
+		srv, err := NewServer(&mockListener{}, WithWarmupLanguages("go", "rust"))
+		require.NoError(t, err)
+		service := &blockingCommitterService{release: make(chan struct{})}
+
+		// Then the server is not ready before the warm-up
+		assert.Equal(t, http.StatusServiceUnavailable, probeReadyz(srv))
+
+		// When the warm-up runs
+		done := make(chan struct{})
+		go func() {
+			srv.warmUp(service)
+			close(done)
+		}()
+
+		// Then the server stays not ready while the warm-up is in flight
+		assert.Equal(t, http.StatusServiceUnavailable, probeReadyz(srv))
+
+		// When the warm-up requests complete
+		close(service.release)
+		<-done
+
+		// Then the server is ready and every language was requested
+		assert.Equal(t, http.StatusOK, probeReadyz(srv))
+		assert.Equal(t, []string{"go", "rust"}, service.requested)
+	})
+
+	t.Run("Ready immediately without configured warm-up", func(t *testing.T) {
+		// Given server without warm-up languages
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+
+		// When the server marks itself ready
+		srv.setReady()
+
+		// Then the readiness probe succeeds
+		assert.Equal(t, http.StatusOK, probeReadyz(srv))
+	})
+
+	t.Run("Not ready when GitHub is not reachable", func(t *testing.T) {
+		// Given a ready server whose GitHub check fails
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+		srv.setReady()
+		srv.githubPing = func(ctx context.Context) error {
+			return errors.New("bad credentials")
+		}
+
+		// Then the readiness probe fails
+		assert.Equal(t, http.StatusServiceUnavailable, probeReadyz(srv))
+	})
+
+	t.Run("Ready when the GitHub check succeeds", func(t *testing.T) {
+		// Given a ready server whose GitHub check succeeds
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+		srv.setReady()
+		var sawDeadline bool
+		srv.githubPing = func(ctx context.Context) error {
+			_, sawDeadline = ctx.Deadline()
+			return nil
+		}
+
+		// Then the readiness probe succeeds and the check was bounded
+		assert.Equal(t, http.StatusOK, probeReadyz(srv))
+		assert.True(t, sawDeadline)
+	})
+}
+
+func TestSwaggerForRequest(t *testing.T) {
+	t.Run("Host and scheme follow the serving server", func(t *testing.T) {
+		// Given cleartext server
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+
+		// When the swagger document is rewritten for a request
+		doc, err := srv.swaggerForRequest("127.0.0.1:9091")
+		require.NoError(t, err)
+
+		// Then the document points back at the serving host over http
+		var swagger map[string]interface{}
+		require.NoError(t, json.Unmarshal(doc, &swagger))
+		assert.Equal(t, "127.0.0.1:9091", swagger["host"])
+		assert.Equal(t, []interface{}{"http"}, swagger["schemes"])
+	})
+
+	t.Run("TLS server advertises https", func(t *testing.T) {
+		// Given TLS server
+		srv, err := NewServer(&mockListener{}, WithSecure(true))
+		require.NoError(t, err)
+
+		// When the swagger document is rewritten for a request
+		doc, err := srv.swaggerForRequest("committer.example:443")
+		require.NoError(t, err)
+
+		// Then the schemes advertise https
+		var swagger map[string]interface{}
+		require.NoError(t, json.Unmarshal(doc, &swagger))
+		assert.Equal(t, []interface{}{"https"}, swagger["schemes"])
+	})
+}
+
+func TestGitHubHTTPClient(t *testing.T) {
+	t.Run("Certificate verification is on by default", func(t *testing.T) {
+		// Given server without the insecure upstream option
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+
+		// When the upstream HTTP client is built
+		tr := srv.githubHTTPClient().Transport.(*http.Transport)
+
+		// Then the default verifying TLS configuration is used
+		assert.Nil(t, tr.TLSClientConfig)
+	})
+
+	t.Run("Skipping verification is opt-in", func(t *testing.T) {
+		// Given server that explicitly opted in
+		srv, err := NewServer(&mockListener{}, WithInsecureUpstream())
+		require.NoError(t, err)
+
+		// When the upstream HTTP client is built
+		tr := srv.githubHTTPClient().Transport.(*http.Transport)
+
+		// Then certificate verification is skipped
+		require.NotNil(t, tr.TLSClientConfig)
+		assert.True(t, tr.TLSClientConfig.InsecureSkipVerify)
+	})
+
+	t.Run("Supplied HTTP client replaces the built one", func(t *testing.T) {
+		// Given server with an injected HTTP client
+		injected := &http.Client{Timeout: 42 * time.Second}
+		srv, err := NewServer(&mockListener{}, WithHTTPClient(injected))
+		require.NoError(t, err)
+
+		// When the upstream HTTP client is built
+		client := srv.githubHTTPClient()
+
+		// Then the injected client is used as-is
+		assert.Same(t, injected, client)
+	})
+}
+
+func TestGitHubClient(t *testing.T) {
+	t.Run("Defaults to github.com", func(t *testing.T) {
+		// Given server without a configured base URL
+		srv, err := NewServer(&mockListener{})
+		require.NoError(t, err)
+
+		// When the GitHub client is built
+		client, err := srv.githubClient()
+
+		// Then it points at the public API
+		require.NoError(t, err)
+		assert.Equal(t, "https://api.github.com/", client.BaseURL.String())
+	})
+
+	t.Run("An enterprise base URL gets its trailing slash", func(t *testing.T) {
+		// Given server pointed at a GitHub Enterprise instance
+		srv, err := NewServer(&mockListener{},
+			WithGitHubBaseURL("https://github.example.com/api/v3"))
+		require.NoError(t, err)
+
+		// When the GitHub client is built
+		client, err := srv.githubClient()
+
+		// Then the base URL carries the trailing slash go-github expects
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.example.com/api/v3/", client.BaseURL.String())
+	})
+
+	t.Run("A malformed base URL is rejected", func(t *testing.T) {
+		// Given server with a relative base URL
+		srv, err := NewServer(&mockListener{}, WithGitHubBaseURL("api/v3"))
+		require.NoError(t, err)
+
+		// When the GitHub client is built
+		_, err = srv.githubClient()
+
+		// Then an error is returned
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "malformed GitHub base URL")
+	})
+}
+
+func TestRequestSizeCost(t *testing.T) {
+	srv := &Server{}
+
+	t.Run("Default request costs a single token", func(t *testing.T) {
+		assert.Equal(t, int64(1), srv.requestSizeCost(&pb.CommitterRequest{}))
+	})
+
+	t.Run("Bigger requests cost more tokens", func(t *testing.T) {
+		assert.Equal(t, int64(10), srv.requestSizeCost(&pb.CommitterRequest{MaxContributors: 100}))
+	})
+
+	t.Run("Batch requests cost the sum of their entries", func(t *testing.T) {
+		assert.Equal(t, int64(11), srv.requestSizeCost(&pb.BatchCommitterRequest{
+			Requests: []*pb.CommitterRequest{
+				{},
+				{MaxContributors: 100},
+			},
+		}))
+	})
+
+	t.Run("Empty batch costs a single token", func(t *testing.T) {
+		assert.Equal(t, int64(1), srv.requestSizeCost(&pb.BatchCommitterRequest{}))
+	})
+
+	t.Run("Unknown request costs a single token", func(t *testing.T) {
+		assert.Equal(t, int64(1), srv.requestSizeCost("not a committer request"))
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+// streamCommitterHandler exposes MostActiveCommitterStream as a Server
+// Sent Events endpoint. Every committer is flushed as its own event so
+// HTTP clients receive incremental results and can cancel early by
+// closing the connection.
+func streamCommitterHandler(client pb.CommitterServiceClient) http.HandlerFunc {
+	marshaler := gatewayJSONMarshaler()
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		stream, err := client.MostActiveCommitterStream(req.Context(), streamRequest(req))
+		if err != nil {
+			http.Error(w, "unable to open the committer stream", http.StatusBadGateway)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+
+		for {
+			committer, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", status.Convert(err).Message())
+				flusher.Flush()
+				return
+			}
+
+			payload, err := marshaler.Marshal(committer)
+			if err != nil {
+				zap.L().Error("Marshaling the streamed committer failed", zap.Error(err))
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			flusher.Flush()
+		}
+	}
+}
+
+// streamRequest maps every SSE query parameter onto the request
+// message, mirroring how the gateway fills them for the unary endpoint.
+// A parameter the gateway would accept must not be dropped here, the
+// stream would silently serve an unfiltered ranking otherwise
+func streamRequest(req *http.Request) *pb.CommitterRequest {
+	query := req.URL.Query()
+
+	pbReq := &pb.CommitterRequest{
+		Language:         query.Get("language"),
+		Languages:        query["languages"],
+		Organizations:    query["organizations"],
+		IncludeAnonymous: query.Get("include_anonymous"),
+		Org:              query.Get("org"),
+		PushedAfter:      query.Get("pushed_after"),
+		PushedBefore:     query.Get("pushed_before"),
+	}
+	if max, err := strconv.ParseUint(query.Get("max_contributors"), 10, 32); err == nil {
+		pbReq.MaxContributors = uint32(max)
+	}
+	if stars, err := strconv.ParseUint(query.Get("min_stars"), 10, 32); err == nil {
+		pbReq.MinStars = uint32(stars)
+	}
+	if sort, ok := pb.RepoSort_value[query.Get("repo_sort")]; ok {
+		pbReq.RepoSort = pb.RepoSort(sort)
+	}
+	if strict, err := strconv.ParseBool(query.Get("strict_language")); err == nil {
+		pbReq.StrictLanguage = strict
+	}
+	if ascending, err := strconv.ParseBool(query.Get("ascending")); err == nil {
+		pbReq.Ascending = ascending
+	}
+	if attribution, ok := pb.AnonAttribution_value[query.Get("anon_attribution")]; ok {
+		pbReq.AnonAttribution = pb.AnonAttribution(attribution)
+	}
+	if page, err := strconv.ParseUint(query.Get("page"), 10, 32); err == nil {
+		pbReq.Page = uint32(page)
+	}
+	if pageSize, err := strconv.ParseUint(query.Get("page_size"), 10, 32); err == nil {
+		pbReq.PageSize = uint32(pageSize)
+	}
+	if mode, ok := pb.ScoreMode_value[query.Get("score_mode")]; ok {
+		pbReq.ScoreMode = pb.ScoreMode(mode)
+	}
+	if short, err := strconv.ParseBool(query.Get("short_circuit")); err == nil {
+		pbReq.ShortCircuit = short
+	}
+	return pbReq
+}
@@ -0,0 +1,73 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/RafalKorepta/most-popular-committer/pkg/api/committer"
+)
+
+func TestStreamRequest(t *testing.T) {
+	t.Run("Every query parameter is mapped onto the request", func(t *testing.T) {
+		// Given SSE request with every parameter set
+		req := httptest.NewRequest("GET", "/v1alpha1/committer/stream?"+
+			"language=go&languages=go&languages=rust&organizations=orga&"+
+			"include_anonymous=true&max_contributors=7&min_stars=100&"+
+			"repo_sort=REPO_SORT_FORKS&strict_language=true&ascending=true&"+
+			"anon_attribution=ANON_ATTRIBUTION_SKIP&page=2&page_size=3&"+
+			"org=kubernetes&pushed_after=2019-01-01T00:00:00Z&"+
+			"pushed_before=2019-06-30T00:00:00Z&score_mode=SCORE_MODE_SHARE&"+
+			"short_circuit=true", nil)
+
+		// When the query parameters are mapped
+		pbReq := streamRequest(req)
+
+		// Then no parameter was dropped
+		assert.Equal(t, &pb.CommitterRequest{
+			Language:         "go",
+			Languages:        []string{"go", "rust"},
+			Organizations:    []string{"orga"},
+			IncludeAnonymous: "true",
+			MaxContributors:  7,
+			MinStars:         100,
+			RepoSort:         pb.RepoSort_REPO_SORT_FORKS,
+			StrictLanguage:   true,
+			Ascending:        true,
+			AnonAttribution:  pb.AnonAttribution_ANON_ATTRIBUTION_SKIP,
+			Page:             2,
+			PageSize:         3,
+			Org:              "kubernetes",
+			PushedAfter:      "2019-01-01T00:00:00Z",
+			PushedBefore:     "2019-06-30T00:00:00Z",
+			ScoreMode:        pb.ScoreMode_SCORE_MODE_SHARE,
+			ShortCircuit:     true,
+		}, pbReq)
+	})
+
+	t.Run("An empty query yields the zero request", func(t *testing.T) {
+		// Given SSE request without parameters
+		req := httptest.NewRequest("GET", "/v1alpha1/committer/stream", nil)
+
+		// When the query parameters are mapped
+		pbReq := streamRequest(req)
+
+		// Then the request carries only zero values
+		assert.Equal(t, &pb.CommitterRequest{Languages: []string(nil), Organizations: []string(nil)}, pbReq)
+	})
+}
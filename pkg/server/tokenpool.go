@@ -0,0 +1,106 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// tokenPool rotates outgoing GitHub requests across several personal
+// access tokens to multiply the rate budget. A token whose budget is
+// exhausted is paused until the reset time GitHub reports. When every
+// token is paused the requests go out unauthenticated.
+type tokenPool struct {
+	base http.RoundTripper
+	now  func() time.Time
+
+	mu          sync.Mutex
+	tokens      []string
+	next        int
+	pausedUntil []time.Time
+}
+
+// newTokenPool wraps base so every request carries the next usable
+// token of the pool
+func newTokenPool(base http.RoundTripper, tokens []string) *tokenPool {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &tokenPool{
+		base:        base,
+		now:         time.Now,
+		tokens:      tokens,
+		pausedUntil: make([]time.Time, len(tokens)),
+	}
+}
+
+func (p *tokenPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	idx, token, ok := p.pick()
+
+	// The request is cloned because a RoundTripper must not modify
+	// the original one
+	authenticated := req.Clone(req.Context())
+	if ok {
+		authenticated.Header.Set("Authorization", "token "+token)
+	} else {
+		zap.L().Warn("Every GitHub token is rate limited, sending unauthenticated request")
+	}
+
+	resp, err := p.base.RoundTrip(authenticated)
+	if err == nil && ok && resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		p.pause(idx, resp.Header.Get("X-RateLimit-Reset"))
+	}
+	return resp, err
+}
+
+// pick returns the next token that is not paused, round-robin over the
+// pool. It reports false when every token is paused.
+func (p *tokenPool) pick() (int, string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	for i := 0; i < len(p.tokens); i++ {
+		idx := (p.next + i) % len(p.tokens)
+		if now.Before(p.pausedUntil[idx]) {
+			continue
+		}
+		p.next = (idx + 1) % len(p.tokens)
+		return idx, p.tokens[idx], true
+	}
+	return 0, "", false
+}
+
+// pause takes the token out of the rotation until the given unix reset
+// timestamp. A malformed timestamp pauses the token for a minute.
+func (p *tokenPool) pause(idx int, reset string) {
+	until := p.now().Add(time.Minute)
+	if seconds, err := strconv.ParseInt(reset, 10, 64); err == nil {
+		until = time.Unix(seconds, 0)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pausedUntil[idx] = until
+
+	zap.L().Warn("Pausing rate limited GitHub token",
+		zap.Int("token", idx),
+		zap.Time("until", until))
+}
@@ -0,0 +1,153 @@
+// Copyright © 2019 Rafal Korepta <rafal.korepta@gmail.com>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTransport collects the Authorization header of every request
+// and serves canned response headers
+type recordingTransport struct {
+	auths   []string
+	headers []http.Header
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.auths = append(t.auths, req.Header.Get("Authorization"))
+
+	header := http.Header{}
+	if len(t.headers) > 0 {
+		header = t.headers[0]
+		t.headers = t.headers[1:]
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}, nil
+}
+
+func poolGet(t *testing.T, pool *tokenPool) {
+	req, err := http.NewRequest(http.MethodGet, "http://github.example/repos", nil)
+	require.NoError(t, err)
+	resp, err := pool.RoundTrip(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestTokenPool(t *testing.T) {
+	t.Run("Requests distribute across the tokens", func(t *testing.T) {
+		// Given a pool of two tokens
+		transport := &recordingTransport{}
+		pool := newTokenPool(transport, []string{"token-a", "token-b"})
+
+		// When four requests go out
+		for i := 0; i < 4; i++ {
+			poolGet(t, pool)
+		}
+
+		// Then the tokens alternate round-robin
+		assert.Equal(t, []string{
+			"token token-a",
+			"token token-b",
+			"token token-a",
+			"token token-b",
+		}, transport.auths)
+	})
+
+	t.Run("Rate limited token is skipped until reset", func(t *testing.T) {
+		// Given a pool of two tokens where the first response
+		// exhausts the first token
+		reset := time.Now().Add(time.Hour)
+		transport := &recordingTransport{
+			headers: []http.Header{
+				{
+					"X-Ratelimit-Remaining": []string{"0"},
+					"X-Ratelimit-Reset":     []string{fmt.Sprintf("%d", reset.Unix())},
+				},
+			},
+		}
+		pool := newTokenPool(transport, []string{"token-a", "token-b"})
+
+		// When three requests go out
+		for i := 0; i < 3; i++ {
+			poolGet(t, pool)
+		}
+
+		// Then the limited token is left out of the rotation
+		assert.Equal(t, []string{
+			"token token-a",
+			"token token-b",
+			"token token-b",
+		}, transport.auths)
+	})
+
+	t.Run("Paused token returns after its reset", func(t *testing.T) {
+		// Given a pool whose first token just got limited
+		now := time.Now()
+		transport := &recordingTransport{
+			headers: []http.Header{
+				{
+					"X-Ratelimit-Remaining": []string{"0"},
+					"X-Ratelimit-Reset":     []string{fmt.Sprintf("%d", now.Add(time.Hour).Unix())},
+				},
+			},
+		}
+		pool := newTokenPool(transport, []string{"token-a", "token-b"})
+		pool.now = func() time.Time { return now }
+		poolGet(t, pool)
+
+		// When the clock passes the reset time
+		pool.now = func() time.Time { return now.Add(2 * time.Hour) }
+		poolGet(t, pool)
+		poolGet(t, pool)
+
+		// Then the token takes part in the rotation again
+		assert.Equal(t, []string{
+			"token token-a",
+			"token token-b",
+			"token token-a",
+		}, transport.auths)
+	})
+
+	t.Run("Unauthenticated when every token is paused", func(t *testing.T) {
+		// Given a pool with a single exhausted token
+		transport := &recordingTransport{
+			headers: []http.Header{
+				{
+					"X-Ratelimit-Remaining": []string{"0"},
+					"X-Ratelimit-Reset":     []string{fmt.Sprintf("%d", time.Now().Add(time.Hour).Unix())},
+				},
+			},
+		}
+		pool := newTokenPool(transport, []string{"token-a"})
+		poolGet(t, pool)
+
+		// When another request goes out
+		poolGet(t, pool)
+
+		// Then it is sent without a token
+		assert.Equal(t, []string{"token token-a", ""}, transport.auths)
+	})
+}